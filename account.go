@@ -0,0 +1,212 @@
+package money
+
+import "sync"
+
+// OverdraftPolicy reports whether the available balance of an Account may reach given
+// amount, so it decides how far an Account may go below zero.
+type OverdraftPolicy func(available Amount) bool
+
+// NoOverdraft keeps the available balance from going below zero.
+func NoOverdraft() OverdraftPolicy {
+	return func(available Amount) bool { return available >= 0 }
+}
+
+// OverdraftLimit lets the available balance go below zero by up to limit minor units.
+func OverdraftLimit(limit Amount) OverdraftPolicy {
+	return func(available Amount) bool { return available >= -limit }
+}
+
+// UnlimitedOverdraft lets the available balance go below zero without a limit.
+func UnlimitedOverdraft() OverdraftPolicy {
+	return func(Amount) bool { return true }
+}
+
+// Account holds the balance of a single Currency, updated atomically from many goroutines.
+// Funds may be reserved by holds, which are captured or released later. The available
+// balance is the balance less the funds on hold, only it's subject to the OverdraftPolicy.
+// Account must be created with NewAccount and must not be copied after first use.
+type Account struct {
+	mu       sync.Mutex
+	currency *Currency
+	policy   OverdraftPolicy
+	balance  Amount
+	held     Amount
+}
+
+// NewAccount creates new Account holding given opening balance, whose Currency is the
+// only one the Account accepts. A nil policy means NoOverdraft.
+func NewAccount(opening *Money, policy OverdraftPolicy) *Account {
+	if policy == nil {
+		policy = NoOverdraft()
+	}
+
+	return &Account{currency: opening.Currency, policy: policy, balance: opening.Amount}
+}
+
+// Credit adds Money to the balance and returns the new balance.
+// It returns ErrCurrencyMismatch for Money of other Currency, ErrInvalidAmount for
+// negative Money and ErrOverflow when the balance doesn't fit into Amount.
+func (a *Account) Credit(m *Money) (*Money, error) {
+	if err := a.check(m); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	b, ok := mutate.calc.addChecked(a.balance, m.Amount)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	a.balance = b
+
+	return a.money(b), nil
+}
+
+// Debit subtracts Money from the balance and returns the new balance.
+// It returns ErrInsufficientFunds when the OverdraftPolicy rejects the resulting available
+// balance, besides errors returned by Credit. The balance is unchanged on errors.
+func (a *Account) Debit(m *Money) (*Money, error) {
+	if err := a.check(m); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.reserve(m.Amount); err != nil {
+		return nil, err
+	}
+
+	a.balance -= m.Amount
+
+	return a.money(a.balance), nil
+}
+
+// Hold reserves Money of the available balance, e.g. when a payment is authorized, and
+// returns the Hold to capture or release it later. It returns the errors of Debit.
+func (a *Account) Hold(m *Money) (*Hold, error) {
+	if err := a.check(m); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.reserve(m.Amount); err != nil {
+		return nil, err
+	}
+
+	held, ok := mutate.calc.addChecked(a.held, m.Amount)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	a.held = held
+
+	return &Hold{account: a, amount: m.Amount}, nil
+}
+
+// Balance returns the balance including funds on hold.
+func (a *Account) Balance() *Money {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.money(a.balance)
+}
+
+// Available returns the balance less the funds on hold.
+func (a *Account) Available() *Money {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.money(a.balance - a.held)
+}
+
+// Held returns the funds on hold.
+func (a *Account) Held() *Money {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.money(a.held)
+}
+
+func (a *Account) check(m *Money) error {
+	if !m.IsValid() {
+		return ErrNilMoney
+	}
+
+	if !a.currency.equals(m.Currency) {
+		return newCurrencyMismatch(a.currency, m.Currency)
+	}
+
+	if m.IsNegative() {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+// reserve checks that amount can be taken from the available balance, a.mu must be held.
+func (a *Account) reserve(amount Amount) error {
+	available, ok := mutate.calc.subtractChecked(a.balance-a.held, amount)
+	if !ok {
+		return ErrOverflow
+	}
+
+	if !a.policy(available) {
+		return ErrInsufficientFunds
+	}
+
+	return nil
+}
+
+func (a *Account) money(amount Amount) *Money {
+	return &Money{Amount: amount, Currency: a.currency}
+}
+
+// Hold is Money reserved on an Account by Account.Hold. Hold is safe for concurrent use.
+type Hold struct {
+	account *Account
+	amount  Amount
+	closed  bool
+}
+
+// Amount returns the Money on hold.
+func (h *Hold) Amount() *Money {
+	return h.account.money(h.amount)
+}
+
+// Capture takes the Money on hold from the balance and returns the new balance.
+// It returns ErrHoldClosed when the Hold was captured or released already.
+func (h *Hold) Capture() (*Money, error) {
+	a := h.account
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if h.closed {
+		return nil, ErrHoldClosed
+	}
+	h.closed = true
+
+	a.held -= h.amount
+	a.balance -= h.amount
+
+	return a.money(a.balance), nil
+}
+
+// Release returns the Money on hold to the available balance.
+// It returns ErrHoldClosed when the Hold was captured or released already.
+func (h *Hold) Release() error {
+	a := h.account
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if h.closed {
+		return ErrHoldClosed
+	}
+	h.closed = true
+
+	a.held -= h.amount
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestAccount(t *testing.T) {
+	a := NewAccount(New(0, EUR), nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, _ = a.Credit(New(3, EUR))
+				_, _ = a.Debit(New(1, EUR))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if m := a.Balance(); m.Amount != 10000 || m.Currency.Code != EUR {
+		t.Errorf("Expected %d %s got %v", 10000, EUR, m)
+	}
+}
+
+func TestAccount_Overdraft(t *testing.T) {
+	tcs := []struct {
+		policy   OverdraftPolicy
+		debit    int64
+		expected Amount
+		err      error
+	}{
+		{NoOverdraft(), 100, 0, nil},
+		{NoOverdraft(), 101, 100, ErrInsufficientFunds},
+		{OverdraftLimit(50), 150, -50, nil},
+		{OverdraftLimit(50), 151, 100, ErrInsufficientFunds},
+		{UnlimitedOverdraft(), 1000000, -999900, nil},
+	}
+
+	for _, tc := range tcs {
+		a := NewAccount(New(100, EUR), tc.policy)
+		if _, err := a.Debit(New(tc.debit, EUR)); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v debiting %d got %v", tc.err, tc.debit, err)
+		}
+
+		if m := a.Balance(); m.Amount != tc.expected {
+			t.Errorf("Expected %d got %d", tc.expected, m.Amount)
+		}
+	}
+
+	a := NewAccount(New(100, EUR), nil)
+	if _, err := a.Debit(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := a.Credit(New(-1, EUR)); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected %v got %v", ErrInvalidAmount, err)
+	}
+}
+
+func TestAccount_Hold(t *testing.T) {
+	a := NewAccount(New(100, EUR), nil)
+
+	h, err := a.Hold(New(70, EUR))
+	if err != nil || a.Available().Amount != 30 || a.Held().Amount != 70 || a.Balance().Amount != 100 {
+		t.Fatalf("Expected %d available got %v (%v)", 30, a.Available(), err)
+	}
+
+	if _, err := a.Debit(New(31, EUR)); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("Expected %v got %v", ErrInsufficientFunds, err)
+	}
+
+	if b, err := h.Capture(); err != nil || b.Amount != 30 || a.Held().Amount != 0 {
+		t.Errorf("Expected balance %d got %v (%v)", 30, b, err)
+	}
+
+	if err := h.Release(); !errors.Is(err, ErrHoldClosed) {
+		t.Errorf("Expected %v got %v", ErrHoldClosed, err)
+	}
+
+	h, _ = a.Hold(New(30, EUR))
+	if err := h.Release(); err != nil || a.Available().Amount != 30 || h.Amount().Amount != 30 {
+		t.Errorf("Expected %d available got %v (%v)", 30, a.Available(), err)
+	}
+}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"encoding/json"
+)
+
+// accountingFraction is the number of decimals QuickBooks and Xero accept for
+// monetary amounts, regardless of the currency.
+const accountingFraction = 2
+
+// CurrencyRef represents the QuickBooks Online currency reference object.
+type CurrencyRef struct {
+	Value string `json:"value"`
+}
+
+// QuickBooksAmount represents an amount with its currency reference as used by
+// the QuickBooks Online API, e.g. {"Amount": 100.00, "CurrencyRef": {"value": "USD"}}.
+type QuickBooksAmount struct {
+	Amount      json.Number `json:"Amount"`
+	CurrencyRef CurrencyRef `json:"CurrencyRef"`
+}
+
+// XeroAmount represents an amount with its currency code as used by the Xero
+// Accounting API, e.g. {"Total": 100.00, "CurrencyCode": "USD"}.
+type XeroAmount struct {
+	Total        json.Number `json:"Total"`
+	CurrencyCode string      `json:"CurrencyCode"`
+}
+
+// ToQuickBooks converts Money into QuickBooks amount object.
+// Amounts of currencies with more than 2 decimals are rounded with given rounding mode.
+func (m *Money) ToQuickBooks(mode RoundingMode) (*QuickBooksAmount, error) {
+	c := m.Currency.get()
+	s, err := accountingAmount(m.Amount, c.Fraction, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuickBooksAmount{Amount: json.Number(s), CurrencyRef: CurrencyRef{Value: c.Code}}, nil
+}
+
+// NewFromQuickBooks creates and returns new instance of Money from QuickBooks amount object.
+func NewFromQuickBooks(a QuickBooksAmount) (*Money, error) {
+	return newFromAccounting(string(a.Amount), a.CurrencyRef.Value)
+}
+
+// ToXero converts Money into Xero amount object.
+// Amounts of currencies with more than 2 decimals are rounded with given rounding mode.
+func (m *Money) ToXero(mode RoundingMode) (*XeroAmount, error) {
+	c := m.Currency.get()
+	s, err := accountingAmount(m.Amount, c.Fraction, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &XeroAmount{Total: json.Number(s), CurrencyCode: c.Code}, nil
+}
+
+// NewFromXero creates and returns new instance of Money from Xero amount object.
+func NewFromXero(a XeroAmount) (*Money, error) {
+	return newFromAccounting(string(a.Total), a.CurrencyCode)
+}
+
+func accountingAmount(amount Amount, fraction int, mode RoundingMode) (string, error) {
+	a, err := roundAmount(amount, fraction, accountingFraction, mode)
+	if err != nil {
+		return "", err
+	}
+
+	return formatDecimal(a, accountingFraction), nil
+}
+
+func newFromAccounting(s, code string) (*Money, error) {
+	if code == "" {
+		return nil, &UnsupportedCurrencyError{Code: code}
+	}
+
+	a, err := parseDecimal(s, accountingFraction)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := NewWithOptions(0, code)
+	if err != nil {
+		return nil, err
+	}
+	if m.Amount, err = rescaleAmount(a, accountingFraction, m.Currency.Fraction); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,69 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMoney_ToQuickBooks(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		mode     RoundingMode
+		expected string
+	}{
+		{10000, USD, RoundHalfUp, `{"Amount":100.00,"CurrencyRef":{"value":"USD"}}`},
+		{1500, JPY, RoundHalfUp, `{"Amount":1500.00,"CurrencyRef":{"value":"JPY"}}`},
+		{1235, KWD, RoundHalfUp, `{"Amount":1.24,"CurrencyRef":{"value":"KWD"}}`},
+		{1235, KWD, RoundHalfEven, `{"Amount":1.24,"CurrencyRef":{"value":"KWD"}}`},
+		{1225, KWD, RoundHalfEven, `{"Amount":1.22,"CurrencyRef":{"value":"KWD"}}`},
+		{-1235, KWD, RoundDown, `{"Amount":-1.23,"CurrencyRef":{"value":"KWD"}}`},
+	}
+
+	for _, tc := range tcs {
+		a, err := New(tc.amount, tc.code).ToQuickBooks(tc.mode)
+		b, _ := json.Marshal(a)
+
+		if err != nil || string(b) != tc.expected {
+			t.Errorf("Expected %s got %s (%v)", tc.expected, b, err)
+		}
+	}
+}
+
+func TestNewFromQuickBooks(t *testing.T) {
+	var a QuickBooksAmount
+	_ = json.Unmarshal([]byte(`{"Amount":100.5,"CurrencyRef":{"value":"USD"}}`), &a)
+
+	m, err := NewFromQuickBooks(a)
+	if err != nil || m.Amount != 10050 || m.Currency.Code != USD {
+		t.Errorf("Expected %d %s got %v (%v)", 10050, USD, m, err)
+	}
+
+	_, err = NewFromQuickBooks(QuickBooksAmount{Amount: "100.50", CurrencyRef: CurrencyRef{Value: JPY}})
+	if !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("Expected %v got %v", ErrPrecisionLoss, err)
+	}
+
+	_, err = NewFromQuickBooks(QuickBooksAmount{Amount: "100.505", CurrencyRef: CurrencyRef{Value: USD}})
+	if !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("Expected %v got %v", ErrPrecisionLoss, err)
+	}
+}
+
+func TestXeroAmount(t *testing.T) {
+	a, err := New(-1099, EUR).ToXero(RoundHalfUp)
+	if err != nil || a.Total != "-10.99" || a.CurrencyCode != EUR {
+		t.Errorf("Expected %s %s got %+v (%v)", "-10.99", EUR, a, err)
+	}
+
+	m, err := NewFromXero(XeroAmount{Total: "1.20", CurrencyCode: KWD})
+	if err != nil || m.Amount != 1200 {
+		t.Errorf("Expected %d got %v (%v)", 1200, m, err)
+	}
+
+	_, err = NewFromXero(XeroAmount{Total: "1.20"})
+	if !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+}
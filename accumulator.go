@@ -0,0 +1,148 @@
+package money
+
+import (
+	"math"
+	"math/big"
+)
+
+// Accumulator aggregates Money of a single Currency one value at a time, keeping the
+// count, sum, minimum, maximum and variance without holding the values. The sum is promoted to
+// big.Int once it leaves the range of Amount, so accumulation itself never overflows.
+// The zero value is an empty Accumulator ready to use. Accumulator is not safe for
+// concurrent use.
+type Accumulator struct {
+	currency *Currency
+	count    int64
+	sum      Amount
+	bigSum   *big.Int
+	min, max Amount
+
+	// mean and m2 track the running mean and sum of squared differences
+	// from it in minor units, as in Welford's online algorithm.
+	mean, m2 float64
+}
+
+// Add adds Money to the Accumulator. The first added Money sets the Currency of the
+// Accumulator, Money of any other Currency is rejected with ErrCurrencyMismatch.
+// Nil Money and Money without Currency are rejected with ErrNilMoney.
+func (a *Accumulator) Add(m *Money) error {
+	if !m.IsValid() {
+		return ErrNilMoney
+	}
+
+	if a.currency == nil {
+		a.currency = m.Currency
+		a.min, a.max = m.Amount, m.Amount
+	} else if !a.currency.equals(m.Currency) {
+		return newCurrencyMismatch(a.currency, m.Currency)
+	}
+
+	a.count++
+	a.min = min(a.min, m.Amount)
+	a.max = max(a.max, m.Amount)
+
+	d := float64(m.Amount) - a.mean
+	a.mean += d / float64(a.count)
+	a.m2 += d * (float64(m.Amount) - a.mean)
+
+	if a.bigSum != nil {
+		a.bigSum.Add(a.bigSum, big.NewInt(int64(m.Amount)))
+		return nil
+	}
+
+	s, ok := mutate.calc.addChecked(a.sum, m.Amount)
+	if !ok {
+		a.bigSum = big.NewInt(int64(a.sum))
+		a.bigSum.Add(a.bigSum, big.NewInt(int64(m.Amount)))
+		return nil
+	}
+	a.sum = s
+
+	return nil
+}
+
+// Count returns the number of Money added to the Accumulator.
+func (a *Accumulator) Count() int64 {
+	return a.count
+}
+
+// BigSum returns the exact sum of all Money added to the Accumulator in minor units.
+func (a *Accumulator) BigSum() *big.Int {
+	if a.bigSum != nil {
+		return new(big.Int).Set(a.bigSum)
+	}
+
+	return big.NewInt(int64(a.sum))
+}
+
+// Sum returns the sum of all Money added to the Accumulator.
+// It returns ErrOverflow when the sum doesn't fit into Amount, use BigSum then.
+func (a *Accumulator) Sum() (*Money, error) {
+	if a.count == 0 {
+		return nil, ErrNoValues
+	}
+
+	s := a.BigSum()
+	if !s.IsInt64() {
+		return nil, ErrOverflow
+	}
+
+	return &Money{Amount: Amount(s.Int64()), Currency: a.currency}, nil
+}
+
+// Min returns the smallest Money added to the Accumulator.
+func (a *Accumulator) Min() (*Money, error) {
+	if a.count == 0 {
+		return nil, ErrNoValues
+	}
+
+	return &Money{Amount: a.min, Currency: a.currency}, nil
+}
+
+// Max returns the largest Money added to the Accumulator.
+func (a *Accumulator) Max() (*Money, error) {
+	if a.count == 0 {
+		return nil, ErrNoValues
+	}
+
+	return &Money{Amount: a.max, Currency: a.currency}, nil
+}
+
+// Mean returns the mean of all Money added to the Accumulator rounded with given rounding mode.
+// The mean always fits into Amount, even when the sum doesn't.
+func (a *Accumulator) Mean(mode RoundingMode) (*Money, error) {
+	if a.count == 0 {
+		return nil, ErrNoValues
+	}
+
+	q := roundQuo(a.BigSum(), big.NewInt(a.count), mode)
+
+	return &Money{Amount: Amount(q.Int64()), Currency: a.currency}, nil
+}
+
+// Variance returns the population variance of all Money added to the Accumulator in
+// squared major units. It is computed online in floating point, so it's meant for
+// statistics like anomaly detection rather than for bookkeeping.
+func (a *Accumulator) Variance() (float64, error) {
+	if a.count == 0 {
+		return 0, ErrNoValues
+	}
+
+	return a.m2 / float64(a.count) / math.Pow10(2*a.currency.Fraction), nil
+}
+
+// StdDev returns the population standard deviation of all Money added to the Accumulator
+// rounded to minor units with given rounding mode.
+func (a *Accumulator) StdDev(mode RoundingMode) (*Money, error) {
+	if a.count == 0 {
+		return nil, ErrNoValues
+	}
+
+	r := new(big.Rat).SetFloat64(math.Sqrt(a.m2 / float64(a.count)))
+	q := roundQuo(r.Num(), r.Denom(), mode)
+	if !q.IsInt64() {
+		return nil, ErrOverflow
+	}
+
+	return &Money{Amount: Amount(q.Int64()), Currency: a.currency}, nil
+}
@@ -0,0 +1,102 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestAccumulator(t *testing.T) {
+	var a Accumulator
+
+	for _, m := range moneys(EUR, 300, -100, 200, 50) {
+		if err := a.Add(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sum, _ := a.Sum()
+	min, _ := a.Min()
+	max, _ := a.Max()
+	mean, _ := a.Mean(RoundHalfUp)
+
+	if a.Count() != 4 || sum.Amount != 450 || min.Amount != -100 || max.Amount != 300 || mean.Amount != 113 {
+		t.Errorf("Unexpected count %d sum %d min %d max %d mean %d",
+			a.Count(), sum.Amount, min.Amount, max.Amount, mean.Amount)
+	}
+
+	if err := a.Add(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) || a.Count() != 4 {
+		t.Errorf("Expected %v and unchanged count got %v %d", ErrCurrencyMismatch, err, a.Count())
+	}
+
+	for _, m := range []*Money{nil, {Amount: 1}, {Amount: 1, Currency: &Currency{}}} {
+		if err := a.Add(m); !errors.Is(err, ErrNilMoney) || a.Count() != 4 {
+			t.Errorf("Expected %v and unchanged count got %v %d", ErrNilMoney, err, a.Count())
+		}
+	}
+}
+
+func TestAccumulator_Overflow(t *testing.T) {
+	var a Accumulator
+
+	for i := 0; i < 4; i++ {
+		_ = a.Add(New(math.MaxInt64, EUR))
+	}
+
+	if _, err := a.Sum(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	expected := new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(4))
+	if s := a.BigSum(); s.Cmp(expected) != 0 {
+		t.Errorf("Expected %s got %s", expected, s)
+	}
+
+	if m, err := a.Mean(RoundHalfUp); err != nil || m.Amount != math.MaxInt64 {
+		t.Errorf("Expected %d got %v (%v)", int64(math.MaxInt64), m, err)
+	}
+}
+
+func TestAccumulator_Empty(t *testing.T) {
+	var a Accumulator
+
+	if _, err := a.Sum(); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+
+	if _, err := a.Mean(RoundHalfUp); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+}
+
+func TestAccumulator_Variance(t *testing.T) {
+	var a Accumulator
+
+	for _, m := range moneys(EUR, 200, 400, 400, 400, 500, 500, 700, 900) {
+		_ = a.Add(m)
+	}
+
+	if v, err := a.Variance(); err != nil || math.Abs(v-4) > 1e-9 {
+		t.Errorf("Expected %v got %v (%v)", 4, v, err)
+	}
+
+	if sd, err := a.StdDev(RoundHalfUp); err != nil || sd.Amount != 200 || sd.Currency.Code != EUR {
+		t.Errorf("Expected %d got %v (%v)", 200, sd, err)
+	}
+
+	var b Accumulator
+	for _, m := range moneys(EUR, 1, 2) {
+		_ = b.Add(m)
+	}
+
+	for mode, expected := range map[RoundingMode]Amount{RoundHalfUp: 1, RoundDown: 0} {
+		if sd, _ := b.StdDev(mode); sd.Amount != expected {
+			t.Errorf("Expected %d with %s got %d", expected, mode, sd.Amount)
+		}
+	}
+
+	if _, err := (&Accumulator{}).Variance(); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+}
@@ -0,0 +1,229 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Sum returns new Money struct with value representing the sum of all given Money.
+// All Money must have the same Currency, otherwise ErrCurrencyMismatch is returned.
+// Nil values are skipped, ErrNoValues is returned when there is nothing to sum
+// and ErrOverflow when the sum doesn't fit into Amount. Partial sums may go past the
+// limits of Amount, only the final sum has to fit.
+func Sum(ms ...*Money) (*Money, error) {
+	return SumBy(ms, identity)
+}
+
+// Min works like MinOf for Money given as arguments.
+func Min(ms ...*Money) (*Money, error) {
+	return MinOf(ms)
+}
+
+// Max works like MaxOf for Money given as arguments.
+func Max(ms ...*Money) (*Money, error) {
+	return MaxOf(ms)
+}
+
+// Mean returns new Money struct with value representing the mean of given Money, rounded
+// with given rounding mode. See Average for the rules and to get the rounding residue.
+func Mean(mode RoundingMode, ms ...*Money) (*Money, error) {
+	mean, _, err := Average(ms, mode)
+	return mean, err
+}
+
+// MinOf returns the Money with the smallest value from given slice.
+// When several Money share the smallest value the first one is returned.
+// Nil values are skipped, ErrNoValues is returned when there is nothing to compare
+// and ErrCurrencyMismatch when the Money don't share the same Currency.
+func MinOf(ms []*Money) (*Money, error) {
+	return extremeBy(ms, identity, -1)
+}
+
+// MaxOf returns the Money with the largest value from given slice.
+// When several Money share the largest value the first one is returned.
+// Nil values are skipped, ErrNoValues is returned when there is nothing to compare
+// and ErrCurrencyMismatch when the Money don't share the same Currency.
+func MaxOf(ms []*Money) (*Money, error) {
+	return extremeBy(ms, identity, 1)
+}
+
+// Average returns new Money struct with value representing the mean of given Money,
+// rounded with given rounding mode, along with the rounding residue: the sum of all
+// Money minus the mean multiplied by their count. Nil values are skipped.
+func Average(ms []*Money, mode RoundingMode) (mean, residue *Money, err error) {
+	first, err := firstOfCurrency(ms)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sum := new(big.Int)
+	n := int64(0)
+	for _, m := range ms {
+		if m != nil {
+			sum.Add(sum, big.NewInt(int64(m.Amount)))
+			n++
+		}
+	}
+
+	q := roundQuo(sum, big.NewInt(n), mode)
+	r := new(big.Int).Sub(sum, new(big.Int).Mul(q, big.NewInt(n)))
+
+	return &Money{Amount: Amount(q.Int64()), Currency: first.Currency},
+		&Money{Amount: Amount(r.Int64()), Currency: first.Currency}, nil
+}
+
+// WeightedAverage returns new Money struct with value representing the average of given
+// Money weighted by the weight at the same index. The average is computed exactly and
+// rounded once with given rounding mode. Nil values are skipped along with their weight.
+func WeightedAverage(values []*Money, weights []int64, mode RoundingMode) (*Money, error) {
+	if len(values) != len(weights) {
+		return nil, fmt.Errorf("%w: values and weights must have the same length", ErrInvalidRatio)
+	}
+
+	first, err := firstOfCurrency(values)
+	if err != nil {
+		return nil, err
+	}
+
+	sum, total := new(big.Int), new(big.Int)
+	for i, m := range values {
+		if m == nil {
+			continue
+		}
+
+		if weights[i] < 0 {
+			return nil, fmt.Errorf("%w: negative weights not allowed", ErrInvalidRatio)
+		}
+
+		w := big.NewInt(weights[i])
+		sum.Add(sum, w.Mul(w, big.NewInt(int64(m.Amount))))
+		total.Add(total, big.NewInt(weights[i]))
+	}
+
+	if total.Sign() == 0 {
+		return nil, fmt.Errorf("%w: sum of weights must be higher than zero", ErrInvalidRatio)
+	}
+
+	return &Money{Amount: Amount(roundQuo(sum, total, mode).Int64()), Currency: first.Currency}, nil
+}
+
+// firstOfCurrency returns the first non-nil Money of given slice, checking that all
+// other non-nil Money share its Currency.
+func firstOfCurrency(ms []*Money) (*Money, error) {
+	var first *Money
+	for _, m := range ms {
+		if m == nil {
+			continue
+		}
+
+		if first == nil {
+			first = m
+			continue
+		}
+
+		if err := first.assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+	}
+
+	if first == nil {
+		return nil, ErrNoValues
+	}
+
+	return first, nil
+}
+
+// SumBy returns new Money struct with value representing the sum of Money extracted
+// from each item by f, without building an intermediate slice. See Sum for the rules.
+func SumBy[T any](items []T, f func(T) *Money) (*Money, error) {
+	var sum *Money
+
+	// The sum wraps around on overflow, carry counts how often so it's exact as long as
+	// the final sum fits.
+	carry := 0
+	for _, item := range items {
+		m := f(item)
+		if m == nil {
+			continue
+		}
+
+		if sum == nil {
+			sum = &Money{Amount: m.Amount, Currency: m.Currency}
+			continue
+		}
+
+		if err := sum.assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+
+		a, ok := mutate.calc.addChecked(sum.Amount, m.Amount)
+		if !ok {
+			if m.Amount > 0 {
+				carry++
+			} else {
+				carry--
+			}
+		}
+		sum.Amount = a
+	}
+
+	if sum == nil {
+		return nil, ErrNoValues
+	}
+
+	if carry != 0 {
+		return nil, ErrOverflow
+	}
+
+	return sum, nil
+}
+
+// MinBy returns the item holding the smallest Money extracted by f.
+// When several items share the smallest value the first one is returned. See MinOf for the rules.
+func MinBy[T any](items []T, f func(T) *Money) (T, error) {
+	return extremeBy(items, f, -1)
+}
+
+// MaxBy returns the item holding the largest Money extracted by f.
+// When several items share the largest value the first one is returned. See MaxOf for the rules.
+func MaxBy[T any](items []T, f func(T) *Money) (T, error) {
+	return extremeBy(items, f, 1)
+}
+
+func extremeBy[T any](items []T, f func(T) *Money, sign int) (T, error) {
+	var (
+		r  T
+		rm *Money
+	)
+
+	for _, item := range items {
+		m := f(item)
+		if m == nil {
+			continue
+		}
+
+		if rm == nil {
+			r, rm = item, m
+			continue
+		}
+
+		if err := rm.assertSameCurrency(m); err != nil {
+			var zero T
+			return zero, err
+		}
+
+		if m.compare(rm) == sign {
+			r, rm = item, m
+		}
+	}
+
+	if rm == nil {
+		return r, ErrNoValues
+	}
+
+	return r, nil
+}
+
+func identity(m *Money) *Money {
+	return m
+}
@@ -0,0 +1,224 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	tcs := []struct {
+		ms       []*Money
+		expected Amount
+		err      error
+	}{
+		{[]*Money{New(100, EUR), New(250, EUR), New(-50, EUR)}, 300, nil},
+		{[]*Money{New(100, EUR)}, 100, nil},
+		{[]*Money{nil, New(100, EUR), nil}, 100, nil},
+		{[]*Money{New(100, EUR), New(100, USD)}, 0, ErrCurrencyMismatch},
+		{[]*Money{New(math.MaxInt64, EUR), New(1, EUR)}, 0, ErrOverflow},
+		{[]*Money{New(math.MinInt64, EUR), New(-1, EUR)}, 0, ErrOverflow},
+		{[]*Money{New(math.MaxInt64, EUR), New(1, EUR), New(-1, EUR)}, math.MaxInt64, nil},
+		{[]*Money{New(math.MaxInt64, EUR), New(math.MaxInt64, EUR), New(math.MinInt64, EUR), New(math.MinInt64, EUR)}, -2, nil},
+		{[]*Money{New(math.MaxInt64, EUR), New(math.MaxInt64, EUR), New(math.MinInt64, EUR)}, math.MaxInt64 - 1, nil},
+		{[]*Money{New(math.MaxInt64, EUR), New(math.MaxInt64, EUR), New(1, EUR)}, 0, ErrOverflow},
+		{nil, 0, ErrNoValues},
+		{[]*Money{nil}, 0, ErrNoValues},
+	}
+
+	for _, tc := range tcs {
+		r, err := Sum(tc.ms...)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v got %v", tc.err, err)
+			continue
+		}
+
+		if err == nil && r.Amount != tc.expected {
+			t.Errorf("Expected sum %d got %d", tc.expected, r.Amount)
+		}
+	}
+}
+
+func TestSum_DoesNotMutate(t *testing.T) {
+	m := New(100, EUR)
+	if _, err := Sum(m, New(1, EUR)); err != nil || m.Amount != 100 {
+		t.Errorf("Expected first value to stay %d got %d (%v)", 100, m.Amount, err)
+	}
+}
+
+func TestMinOfMaxOf(t *testing.T) {
+	cheap, pricey := New(100, EUR), New(900, EUR)
+	tcs := []struct {
+		ms  []*Money
+		min *Money
+		max *Money
+		err error
+	}{
+		{[]*Money{New(500, EUR), cheap, pricey, New(100, EUR)}, cheap, pricey, nil},
+		{[]*Money{nil, cheap, nil}, cheap, cheap, nil},
+		{[]*Money{cheap, New(100, USD)}, nil, nil, ErrCurrencyMismatch},
+		{[]*Money{nil}, nil, nil, ErrNoValues},
+		{nil, nil, nil, ErrNoValues},
+	}
+
+	for _, tc := range tcs {
+		min, err := MinOf(tc.ms)
+		if !errors.Is(err, tc.err) || min != tc.min {
+			t.Errorf("Expected min %v (%v) got %v (%v)", tc.min, tc.err, min, err)
+		}
+
+		max, err := MaxOf(tc.ms)
+		if !errors.Is(err, tc.err) || max != tc.max {
+			t.Errorf("Expected max %v (%v) got %v (%v)", tc.max, tc.err, max, err)
+		}
+	}
+}
+
+func TestMinMaxMean(t *testing.T) {
+	cheap, pricey := New(100, EUR), New(900, EUR)
+
+	if m, err := Min(New(500, EUR), pricey, cheap); err != nil || m != cheap {
+		t.Errorf("Expected min %v got %v (%v)", cheap, m, err)
+	}
+
+	if m, err := Max(New(500, EUR), pricey, cheap); err != nil || m != pricey {
+		t.Errorf("Expected max %v got %v (%v)", pricey, m, err)
+	}
+
+	if m, err := Mean(RoundHalfEven, New(1, EUR), New(2, EUR), New(2, EUR), New(5, EUR)); err != nil || m.Amount != 2 {
+		t.Errorf("Expected mean %d got %v (%v)", 2, m, err)
+	}
+
+	if _, err := Min(); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+
+	if _, err := Max(cheap, New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := Mean(RoundHalfUp); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	tcs := []struct {
+		amounts []int64
+		mode    RoundingMode
+		mean    Amount
+		residue Amount
+	}{
+		{[]int64{100, 200, 300}, RoundHalfUp, 200, 0},
+		{[]int64{100, 100, 101}, RoundHalfUp, 100, 1},
+		{[]int64{100, 101, 101}, RoundHalfUp, 101, -1},
+		{[]int64{1, 2}, RoundHalfUp, 2, -1},
+		{[]int64{1, 2}, RoundHalfEven, 2, -1},
+		{[]int64{2, 3}, RoundHalfEven, 2, 1},
+		{[]int64{-1, -2}, RoundHalfUp, -2, 1},
+		{[]int64{-1, -2}, RoundDown, -1, -1},
+		{[]int64{math.MaxInt64, math.MaxInt64}, RoundHalfUp, math.MaxInt64, 0},
+	}
+
+	for _, tc := range tcs {
+		ms := make([]*Money, 0, len(tc.amounts))
+		for _, a := range tc.amounts {
+			ms = append(ms, New(a, EUR))
+		}
+
+		mean, residue, err := Average(ms, tc.mode)
+		if err != nil || mean.Amount != tc.mean || residue.Amount != tc.residue {
+			t.Errorf("Expected average of %v rounded %s to be %d residue %d got %v residue %v (%v)",
+				tc.amounts, tc.mode, tc.mean, tc.residue, mean, residue, err)
+		}
+	}
+
+	if _, _, err := Average([]*Money{New(1, EUR), New(1, USD)}, RoundHalfUp); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, _, err := Average(nil, RoundHalfUp); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+}
+
+func TestWeightedAverage(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		weights  []int64
+		expected Amount
+	}{
+		{[]int64{1000, 2000}, []int64{1, 1}, 1500},
+		{[]int64{1000, 2000}, []int64{3, 1}, 1250},
+		{[]int64{1000, 1001}, []int64{2, 1}, 1000},
+		{[]int64{1000, 1001}, []int64{1, 2}, 1001},
+		{[]int64{1000, 2000}, []int64{0, 5}, 2000},
+		{[]int64{math.MaxInt64, math.MaxInt64}, []int64{math.MaxInt64, math.MaxInt64}, math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		m, err := WeightedAverage(moneys(EUR, tc.amounts...), tc.weights, RoundHalfUp)
+
+		if err != nil || m.Amount != tc.expected {
+			t.Errorf("Expected weighted average of %v by %v to be %d got %v (%v)",
+				tc.amounts, tc.weights, tc.expected, m, err)
+		}
+	}
+
+	errs := []struct {
+		ms      []*Money
+		weights []int64
+	}{
+		{moneys(EUR, 1, 2), []int64{1}},
+		{moneys(EUR, 1, 2), []int64{1, -1}},
+		{moneys(EUR, 1, 2), []int64{0, 0}},
+		{[]*Money{New(1, EUR), New(1, USD)}, []int64{1, 1}},
+		{nil, nil},
+	}
+
+	for _, tc := range errs {
+		if _, err := WeightedAverage(tc.ms, tc.weights, RoundHalfUp); err == nil {
+			t.Errorf("Expected err for %v by %v", tc.ms, tc.weights)
+		}
+	}
+}
+
+type orderLine struct {
+	sku   string
+	total *Money
+}
+
+func TestSumByMinByMaxBy(t *testing.T) {
+	lines := []orderLine{
+		{"a", New(500, EUR)},
+		{"b", New(100, EUR)},
+		{"c", nil},
+		{"d", New(900, EUR)},
+	}
+	total := func(l orderLine) *Money { return l.total }
+
+	sum, err := SumBy(lines, total)
+	if err != nil || sum.Amount != 1500 {
+		t.Errorf("Expected sum %d got %v (%v)", 1500, sum, err)
+	}
+
+	cheapest, err := MinBy(lines, total)
+	if err != nil || cheapest.sku != "b" {
+		t.Errorf("Expected cheapest %s got %s (%v)", "b", cheapest.sku, err)
+	}
+
+	priciest, err := MaxBy(lines, total)
+	if err != nil || priciest.sku != "d" {
+		t.Errorf("Expected priciest %s got %s (%v)", "d", priciest.sku, err)
+	}
+
+	lines = append(lines, orderLine{"e", New(1, USD)})
+	if _, err := MaxBy(lines, total); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := MinBy([]orderLine{}, total); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+}
@@ -0,0 +1,106 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// AllocateWeighted works like Allocate but takes weights like percentages, e.g. 33.33 and
+// 66.67. Each weight is taken as its shortest decimal representation, so the shares are
+// exact in proportion to the weights as written. It returns ErrInvalidRatio for no,
+// negative, NaN or infinite weights.
+func (m *Money) AllocateWeighted(weights ...float64) ([]*Money, error) {
+	rs := make([]*big.Rat, len(weights))
+	for i, w := range weights {
+		if math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("%w: weight %v", ErrInvalidRatio, w)
+		}
+
+		rs[i], _ = new(big.Rat).SetString(strconv.FormatFloat(w, 'g', -1, 64))
+	}
+
+	return m.AllocateRat(rs...)
+}
+
+// AllocateRat works like Allocate but takes rational ratios. Every party gets its share
+// of the amount truncated to minor units, and leftover minor units are distributed one by
+// one to the first parties. When all ratios are zero all parties get zero. It returns
+// ErrInvalidRatio for no, nil or negative ratios.
+func (m *Money) AllocateRat(rs ...*big.Rat) ([]*Money, error) {
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("%w: no ratios specified", ErrInvalidRatio)
+	}
+
+	sum := new(big.Rat)
+	for _, r := range rs {
+		if r == nil || r.Sign() < 0 {
+			return nil, fmt.Errorf("%w: negative ratios not allowed", ErrInvalidRatio)
+		}
+		sum.Add(sum, r)
+	}
+
+	as := make([]Amount, len(rs))
+	if sum.Sign() == 0 {
+		return m.parties(as), nil
+	}
+
+	a := big.NewInt(int64(m.Amount))
+	var total Amount
+	for i, r := range rs {
+		// a * r / sum, each share is at most a, so it fits into Amount.
+		n := new(big.Int).Mul(a, r.Num())
+		n.Mul(n, sum.Denom())
+		d := new(big.Int).Mul(r.Denom(), sum.Num())
+
+		as[i] = Amount(n.Quo(n, d).Int64())
+		total += as[i]
+	}
+
+	spreadLeftover(as, m.Amount-total)
+
+	return m.parties(as), nil
+}
+
+// AllocateFixed carves fixed amounts out of Money and gives the remainder to the party at
+// index remainderTo, on top of its own fixed amount. Nil parts are taken as zero. The
+// parts must be of the Currency of the Money and of its sign or zero, and must not add up
+// to more than it. It returns ErrInvalidRatio for remainderTo out of range, ErrNilMoney,
+// ErrNilCurrency, ErrCurrencyMismatch and ErrInvalidAllocation for parts breaking these
+// rules.
+func (m *Money) AllocateFixed(parts []*Money, remainderTo int) ([]*Money, error) {
+	if remainderTo < 0 || remainderTo >= len(parts) {
+		return nil, fmt.Errorf("%w: remainder party %d out of range", ErrInvalidRatio, remainderTo)
+	}
+
+	if err := m.assertSameCurrency(m); err != nil {
+		return nil, err
+	}
+
+	as := make([]Amount, len(parts))
+	var total Amount
+	for i, p := range parts {
+		if p == nil {
+			continue
+		}
+
+		if err := m.assertSameCurrency(p); err != nil {
+			return nil, err
+		}
+
+		if (p.Amount > 0 && m.Amount < 0) || (p.Amount < 0 && m.Amount >= 0) {
+			return nil, fmt.Errorf("%w: part %d of %s has the opposite sign of %s", ErrInvalidAllocation, i, p.Display(), m.Display())
+		}
+
+		var ok bool
+		if total, ok = mutate.calc.addChecked(total, p.Amount); !ok || (m.Amount >= 0 && total > m.Amount) || (m.Amount < 0 && total < m.Amount) {
+			return nil, fmt.Errorf("%w: parts exceed %s", ErrInvalidAllocation, m.Display())
+		}
+		as[i] = p.Amount
+	}
+
+	as[remainderTo] += m.Amount - total
+
+	return m.parties(as), nil
+}
@@ -0,0 +1,100 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestMoney_AllocateWeighted(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		weights  []float64
+		expected []int64
+	}{
+		{10000, []float64{33.33, 66.67}, []int64{3333, 6667}},
+		{100, []float64{33.33, 33.33, 33.34}, []int64{34, 33, 33}},
+		{-100, []float64{33.33, 33.33, 33.34}, []int64{-34, -33, -33}},
+		{1, []float64{0.5, 0.5}, []int64{1, 0}},
+		{100, []float64{0.1, 0.2}, []int64{34, 66}},
+		{100, []float64{0, 0}, []int64{0, 0}},
+		{100, []float64{0, 1}, []int64{0, 100}},
+		{math.MaxInt64, []float64{1, 1}, []int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2}},
+	}
+
+	for _, tc := range tcs {
+		parts, err := New(tc.amount, EUR).AllocateWeighted(tc.weights...)
+		if err != nil || !reflect.DeepEqual(amounts(parts), tc.expected) {
+			t.Errorf("Expected %d by %v to be %v got %v (%v)", tc.amount, tc.weights, tc.expected, amounts(parts), err)
+		}
+	}
+
+	for _, ws := range [][]float64{nil, {1, -1}, {math.NaN()}, {math.Inf(1)}} {
+		if _, err := New(100, EUR).AllocateWeighted(ws...); !errors.Is(err, ErrInvalidRatio) {
+			t.Errorf("Expected %v for %v got %v", ErrInvalidRatio, ws, err)
+		}
+	}
+}
+
+func TestMoney_AllocateRat(t *testing.T) {
+	parts, err := New(1000, EUR).AllocateRat(big.NewRat(1, 3), big.NewRat(1, 3), big.NewRat(1, 3))
+	if expected := []int64{334, 333, 333}; err != nil || !reflect.DeepEqual(amounts(parts), expected) {
+		t.Errorf("Expected %v got %v (%v)", expected, amounts(parts), err)
+	}
+
+	if _, err := New(1000, EUR).AllocateRat(big.NewRat(1, 3), nil); !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Expected %v got %v", ErrInvalidRatio, err)
+	}
+}
+
+func TestMoney_AllocateFixed(t *testing.T) {
+	tcs := []struct {
+		amount      int64
+		parts       []*Money
+		remainderTo int
+		expected    []int64
+	}{
+		{10000, []*Money{New(1500, EUR), nil, New(250, EUR)}, 1, []int64{1500, 8250, 250}},
+		{10000, []*Money{New(1500, EUR), New(500, EUR)}, 1, []int64{1500, 8500}},
+		{10000, []*Money{New(4000, EUR), New(6000, EUR)}, 0, []int64{4000, 6000}},
+		{-10000, []*Money{New(-1500, EUR), nil}, 1, []int64{-1500, -8500}},
+		{0, []*Money{nil, New(0, EUR)}, 0, []int64{0, 0}},
+	}
+
+	for _, tc := range tcs {
+		parts, err := New(tc.amount, EUR).AllocateFixed(tc.parts, tc.remainderTo)
+		if err != nil || !reflect.DeepEqual(amounts(parts), tc.expected) {
+			t.Errorf("Expected %d to be %v got %v (%v)", tc.amount, tc.expected, amounts(parts), err)
+		}
+	}
+}
+
+func TestMoney_AllocateFixedErrors(t *testing.T) {
+	tcs := []struct {
+		amount      int64
+		parts       []*Money
+		remainderTo int
+		err         error
+	}{
+		{100, []*Money{New(1, EUR)}, 1, ErrInvalidRatio},
+		{100, nil, 0, ErrInvalidRatio},
+		{100, []*Money{New(1, USD), nil}, 1, ErrCurrencyMismatch},
+		{100, []*Money{New(-1, EUR), nil}, 1, ErrInvalidAllocation},
+		{-100, []*Money{New(1, EUR), nil}, 1, ErrInvalidAllocation},
+		{100, []*Money{New(60, EUR), New(50, EUR)}, 1, ErrInvalidAllocation},
+		{-100, []*Money{New(-60, EUR), New(-50, EUR)}, 1, ErrInvalidAllocation},
+		{100, []*Money{New(math.MaxInt64, EUR), New(math.MaxInt64, EUR)}, 1, ErrInvalidAllocation},
+	}
+
+	for _, tc := range tcs {
+		if _, err := New(tc.amount, EUR).AllocateFixed(tc.parts, tc.remainderTo); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %d and %v got %v", tc.err, tc.amount, amounts(tc.parts), err)
+		}
+	}
+
+	if _, err := (&Money{}).AllocateFixed([]*Money{nil}, 0); !errors.Is(err, ErrNilCurrency) {
+		t.Errorf("Expected %v got %v", ErrNilCurrency, err)
+	}
+}
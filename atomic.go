@@ -0,0 +1,56 @@
+package money
+
+import "sync/atomic"
+
+// AtomicMoney is Money of a fixed Currency that can be updated atomically from many
+// goroutines without additional locking, e.g. to accumulate revenue for metrics.
+// AtomicMoney must be created with NewAtomicMoney and must not be copied after first use.
+type AtomicMoney struct {
+	currency *Currency
+	amount   atomic.Int64
+}
+
+// NewAtomicMoney creates new AtomicMoney holding given Money. Later operations only accept
+// Money of its Currency.
+func NewAtomicMoney(m *Money) *AtomicMoney {
+	a := &AtomicMoney{currency: m.Currency}
+	a.amount.Store(int64(m.Amount))
+
+	return a
+}
+
+// Add atomically adds Money and returns the new value.
+// It returns ErrCurrencyMismatch for Money of other Currency and ErrOverflow when
+// the result doesn't fit into Amount, leaving the value unchanged.
+func (a *AtomicMoney) Add(m *Money) (*Money, error) {
+	if !a.currency.equals(m.Currency) {
+		return nil, newCurrencyMismatch(a.currency, m.Currency)
+	}
+
+	for {
+		old := Amount(a.amount.Load())
+		n, ok := mutate.calc.addChecked(old, m.Amount)
+		if !ok {
+			return nil, ErrOverflow
+		}
+
+		if a.amount.CompareAndSwap(int64(old), int64(n)) {
+			return &Money{Amount: n, Currency: a.currency}, nil
+		}
+	}
+}
+
+// Load atomically loads the current value.
+func (a *AtomicMoney) Load() *Money {
+	return &Money{Amount: Amount(a.amount.Load()), Currency: a.currency}
+}
+
+// Swap atomically stores given Money and returns the previous value, e.g. to read and
+// reset a counter at the end of a day. It returns ErrCurrencyMismatch for Money of other Currency.
+func (a *AtomicMoney) Swap(m *Money) (*Money, error) {
+	if !a.currency.equals(m.Currency) {
+		return nil, newCurrencyMismatch(a.currency, m.Currency)
+	}
+
+	return &Money{Amount: Amount(a.amount.Swap(int64(m.Amount))), Currency: a.currency}, nil
+}
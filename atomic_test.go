@@ -0,0 +1,53 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestAtomicMoney(t *testing.T) {
+	a := NewAtomicMoney(New(0, EUR))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, _ = a.Add(New(3, EUR))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if m := a.Load(); m.Amount != 15000 || m.Currency.Code != EUR {
+		t.Errorf("Expected %d %s got %v", 15000, EUR, m)
+	}
+
+	old, err := a.Swap(New(0, EUR))
+	if err != nil || old.Amount != 15000 || a.Load().Amount != 0 {
+		t.Errorf("Expected %d and reset got %v %d (%v)", 15000, old, a.Load().Amount, err)
+	}
+}
+
+func TestAtomicMoney_Errors(t *testing.T) {
+	a := NewAtomicMoney(New(math.MaxInt64, EUR))
+
+	if _, err := a.Add(New(1, EUR)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := a.Add(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := a.Swap(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if a.Load().Amount != math.MaxInt64 {
+		t.Errorf("Expected unchanged value got %d", a.Load().Amount)
+	}
+}
@@ -0,0 +1,143 @@
+package money
+
+import "sync"
+
+// Authorization models a card payment: an amount is authorized, captured in one or more
+// partial captures and refunded partially or fully. Captures never exceed the authorized
+// amount and refunds never exceed the captured amount. Authorization is safe for concurrent use.
+type Authorization struct {
+	mu         sync.Mutex
+	currency   *Currency
+	authorized Amount
+	captured   Amount
+	refunded   Amount
+	voided     bool
+}
+
+// NewAuthorization creates new Authorization of given Money.
+// It returns ErrInvalidAmount for negative Money.
+func NewAuthorization(m *Money) (*Authorization, error) {
+	if !m.IsValid() {
+		return nil, ErrNilMoney
+	}
+
+	if m.IsNegative() {
+		return nil, ErrInvalidAmount
+	}
+
+	return &Authorization{currency: m.Currency, authorized: m.Amount}, nil
+}
+
+// Capture captures Money of the authorized amount and returns the total captured.
+// It returns ErrExceedsAuthorization when Money is higher than the amount left to capture
+// and ErrAuthorizationVoided after Void.
+func (a *Authorization) Capture(m *Money) (*Money, error) {
+	if err := a.check(m); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.voided {
+		return nil, ErrAuthorizationVoided
+	}
+
+	if m.Amount > a.authorized-a.captured {
+		return nil, ErrExceedsAuthorization
+	}
+	a.captured += m.Amount
+
+	return a.money(a.captured), nil
+}
+
+// Refund refunds Money of the captured amount and returns the total refunded.
+// It returns ErrExceedsCapture when Money is higher than the amount left to refund.
+func (a *Authorization) Refund(m *Money) (*Money, error) {
+	if err := a.check(m); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if m.Amount > a.captured-a.refunded {
+		return nil, ErrExceedsCapture
+	}
+	a.refunded += m.Amount
+
+	return a.money(a.refunded), nil
+}
+
+// Void releases the amount not captured yet, no further captures are possible.
+// Refunds of the captured amount are still possible.
+func (a *Authorization) Void() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.voided = true
+}
+
+// Authorized returns the authorized amount.
+func (a *Authorization) Authorized() *Money {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.money(a.authorized)
+}
+
+// Captured returns the total captured amount, including the refunded part.
+func (a *Authorization) Captured() *Money {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.money(a.captured)
+}
+
+// Refunded returns the total refunded amount.
+func (a *Authorization) Refunded() *Money {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.money(a.refunded)
+}
+
+// Capturable returns the amount left to capture, zero once voided.
+func (a *Authorization) Capturable() *Money {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.voided {
+		return a.money(0)
+	}
+
+	return a.money(a.authorized - a.captured)
+}
+
+// Settled returns the captured amount less refunds, that is what the payer is charged.
+func (a *Authorization) Settled() *Money {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.money(a.captured - a.refunded)
+}
+
+func (a *Authorization) check(m *Money) error {
+	if !m.IsValid() {
+		return ErrNilMoney
+	}
+
+	if !a.currency.equals(m.Currency) {
+		return newCurrencyMismatch(a.currency, m.Currency)
+	}
+
+	if m.IsNegative() {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+func (a *Authorization) money(amount Amount) *Money {
+	return &Money{Amount: amount, Currency: a.currency}
+}
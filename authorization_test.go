@@ -0,0 +1,52 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAuthorization(t *testing.T) {
+	a, err := NewAuthorization(New(10000, USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		op       func() (*Money, error)
+		expected Amount
+		err      error
+	}{
+		{func() (*Money, error) { return a.Capture(New(4000, USD)) }, 4000, nil},
+		{func() (*Money, error) { return a.Capture(New(6001, USD)) }, 0, ErrExceedsAuthorization},
+		{func() (*Money, error) { return a.Capture(New(5000, USD)) }, 9000, nil},
+		{func() (*Money, error) { return a.Refund(New(9001, USD)) }, 0, ErrExceedsCapture},
+		{func() (*Money, error) { return a.Refund(New(2500, USD)) }, 2500, nil},
+		{func() (*Money, error) { return a.Capture(New(-1, USD)) }, 0, ErrInvalidAmount},
+		{func() (*Money, error) { return a.Capture(New(1, EUR)) }, 0, ErrCurrencyMismatch},
+	}
+
+	for i, tc := range tcs {
+		m, err := tc.op()
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v in step %d got %v", tc.err, i, err)
+			continue
+		}
+
+		if err == nil && m.Amount != tc.expected {
+			t.Errorf("Expected %d in step %d got %d", tc.expected, i, m.Amount)
+		}
+	}
+
+	if c, s := a.Capturable(), a.Settled(); c.Amount != 1000 || s.Amount != 6500 {
+		t.Errorf("Expected %d capturable and %d settled got %v and %v", 1000, 6500, c, s)
+	}
+
+	a.Void()
+	if _, err := a.Capture(New(1, USD)); !errors.Is(err, ErrAuthorizationVoided) || !a.Capturable().IsZero() {
+		t.Errorf("Expected %v got %v", ErrAuthorizationVoided, err)
+	}
+
+	if r, err := a.Refund(New(6500, USD)); err != nil || r.Amount != 9000 || !a.Settled().IsZero() {
+		t.Errorf("Expected full refund after void got %v (%v)", r, err)
+	}
+}
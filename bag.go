@@ -0,0 +1,187 @@
+package money
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// Bag holds one total per currency, for values that can't be summed into a single Money.
+// The zero value is an empty Bag ready to use. Bag is not safe for concurrent use.
+type Bag struct {
+	totals map[string]*Money
+}
+
+// NewBag creates new Bag holding the totals of given Money.
+func NewBag(ms ...*Money) (*Bag, error) {
+	b := &Bag{}
+	for _, m := range ms {
+		if err := b.Add(m); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// Add adds Money to the total of its currency. Nil Money is ignored, Money without
+// Currency is rejected with ErrNilCurrency.
+func (b *Bag) Add(m *Money) error {
+	return b.apply(m, mutate.calc.addChecked)
+}
+
+// Subtract subtracts Money from the total of its currency. Nil Money is ignored, Money
+// without Currency is rejected with ErrNilCurrency.
+func (b *Bag) Subtract(m *Money) error {
+	return b.apply(m, mutate.calc.subtractChecked)
+}
+
+func (b *Bag) apply(m *Money, op func(a, b Amount) (Amount, bool)) error {
+	if m == nil {
+		return nil
+	}
+
+	if !m.IsValid() {
+		return ErrNilCurrency
+	}
+
+	if b.totals == nil {
+		b.totals = make(map[string]*Money)
+	}
+
+	code := canonicalCode(m.Currency.Code)
+	t, ok := b.totals[code]
+	if !ok {
+		t = &Money{Currency: m.Currency}
+	}
+
+	a, ok := op(t.Amount, m.Amount)
+	if !ok {
+		return ErrOverflow
+	}
+
+	b.totals[code] = &Money{Amount: a, Currency: t.Currency}
+
+	return nil
+}
+
+// Get returns the total of given currency, zero Money when the Bag holds none. The zero
+// Money has no Currency when the UnknownCodePolicy rejects the code.
+func (b *Bag) Get(code string) *Money {
+	if t, ok := b.totals[canonicalCode(code)]; ok {
+		return &Money{Amount: t.Amount, Currency: t.Currency}
+	}
+
+	c, _ := resolveCode(code)
+	return &Money{Currency: c}
+}
+
+// Len returns the number of currencies held by the Bag.
+func (b *Bag) Len() int {
+	return len(b.totals)
+}
+
+// Codes returns the sorted codes of currencies held by the Bag.
+func (b *Bag) Codes() []string {
+	codes := make([]string, 0, len(b.totals))
+	for code := range b.totals {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+
+	return codes
+}
+
+// Totals returns the totals held by the Bag ordered by currency code.
+func (b *Bag) Totals() []*Money {
+	ms := make([]*Money, 0, len(b.totals))
+	for _, code := range b.Codes() {
+		ms = append(ms, b.Get(code))
+	}
+
+	return ms
+}
+
+// TotalsByCode returns the totals held by the Bag keyed by currency code. The map and
+// the Money in it are copies, changing them doesn't affect the Bag.
+func (b *Bag) TotalsByCode() map[string]*Money {
+	ts := make(map[string]*Money, len(b.totals))
+	for code, t := range b.totals {
+		ts[code] = &Money{Amount: t.Amount, Currency: t.Currency}
+	}
+
+	return ts
+}
+
+// ConvertAll converts every total of the Bag to given currency with the rates of the
+// RateProvider, see Convert, and returns their sum. Each total is rounded with given
+// mode on its own before summing, like separate payments would be. It returns the
+// errors of the RateProvider and ErrOverflow when a total or the sum doesn't fit into
+// Amount. An empty Bag converts to zero Money of the currency.
+func (b *Bag) ConvertAll(to string, rp RateProvider, mode RoundingMode) (*Money, error) {
+	sum, err := NewWithOptions(0, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, code := range b.Codes() {
+		cm, _, err := Convert(b.totals[code], to, rp, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		a, ok := mutate.calc.addChecked(sum.Amount, cm.Amount)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		sum.Amount = a
+	}
+
+	return sum, nil
+}
+
+// IsZero returns boolean of whether all totals held by the Bag are equal to zero.
+func (b *Bag) IsZero() bool {
+	for _, t := range b.totals {
+		if !t.IsZero() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MarshalJSON implements json.Marshaler. Bag is encoded as an object of
+// amounts keyed by currency code, e.g. {"EUR":1099,"USD":-250}.
+func (b *Bag) MarshalJSON() ([]byte, error) {
+	as := make(map[string]Amount, len(b.totals))
+	for code, t := range b.totals {
+		as[code] = t.Amount
+	}
+
+	return json.Marshal(as)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Amounts of codes which differ only by
+// case or surrounding spaces, e.g. "usd" and "USD", are added up. It returns ErrOverflow
+// when their total doesn't fit into Amount.
+func (b *Bag) UnmarshalJSON(data []byte) error {
+	var as map[string]Amount
+	if err := json.Unmarshal(data, &as); err != nil {
+		return err
+	}
+
+	ub := &Bag{totals: make(map[string]*Money, len(as))}
+	for code, a := range as {
+		m, err := NewWithOptions(int64(a), code)
+		if err != nil {
+			return err
+		}
+
+		if err := ub.Add(m); err != nil {
+			return err
+		}
+	}
+	b.totals = ub.totals
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestBag(t *testing.T) {
+	b, err := NewBag(New(100, EUR), New(250, USD), New(50, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Subtract(New(300, USD)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Add(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		code     string
+		expected Amount
+	}{
+		{EUR, 150},
+		{"usd", -50},
+		{GBP, 0},
+	}
+
+	for _, tc := range tcs {
+		if m := b.Get(tc.code); m.Amount != tc.expected {
+			t.Errorf("Expected %s total %d got %d", tc.code, tc.expected, m.Amount)
+		}
+	}
+
+	if codes := b.Codes(); !reflect.DeepEqual(codes, []string{EUR, USD}) {
+		t.Errorf("Expected codes %v got %v", []string{EUR, USD}, codes)
+	}
+
+	if ts := b.Totals(); len(ts) != 2 || ts[0].Currency.Code != EUR || ts[1].Amount != -50 {
+		t.Errorf("Unexpected totals %v", ts)
+	}
+
+	if b.IsZero() {
+		t.Error("Expected bag not to be zero")
+	}
+}
+
+func TestBag_ZeroValue(t *testing.T) {
+	var b Bag
+
+	if b.Len() != 0 || !b.IsZero() {
+		t.Error("Expected empty bag")
+	}
+
+	if err := b.Add(New(1, EUR)); err != nil || b.Len() != 1 {
+		t.Errorf("Expected bag with 1 currency got %d (%v)", b.Len(), err)
+	}
+}
+
+func TestBag_NilCurrency(t *testing.T) {
+	var b Bag
+
+	if err := b.Add(&Money{Amount: 1}); !errors.Is(err, ErrNilCurrency) {
+		t.Errorf("Expected %v got %v", ErrNilCurrency, err)
+	}
+
+	if err := b.Subtract(&Money{Amount: 1, Currency: &Currency{}}); !errors.Is(err, ErrNilCurrency) {
+		t.Errorf("Expected %v got %v", ErrNilCurrency, err)
+	}
+
+	if _, err := NewBag(New(1, EUR), &Money{}); !errors.Is(err, ErrNilCurrency) {
+		t.Errorf("Expected %v got %v", ErrNilCurrency, err)
+	}
+}
+
+func TestBag_Overflow(t *testing.T) {
+	b, _ := NewBag(New(math.MaxInt64, EUR))
+
+	if err := b.Add(New(1, EUR)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if m := b.Get(EUR); m.Amount != math.MaxInt64 {
+		t.Errorf("Expected total to stay %d got %d", int64(math.MaxInt64), m.Amount)
+	}
+}
+
+func TestBag_JSON(t *testing.T) {
+	b, _ := NewBag(New(1099, EUR), New(-250, USD))
+
+	data, err := json.Marshal(b)
+	if err != nil || string(data) != `{"EUR":1099,"USD":-250}` {
+		t.Errorf("Expected %s got %s (%v)", `{"EUR":1099,"USD":-250}`, data, err)
+	}
+
+	var ub Bag
+	if err := json.Unmarshal([]byte(`{"eur":1099,"USD":-250}`), &ub); err != nil {
+		t.Fatal(err)
+	}
+
+	if m := ub.Get(EUR); m.Amount != 1099 || m.Currency.Grapheme != "€" {
+		t.Errorf("Expected %d € got %v", 1099, m)
+	}
+
+	if err := json.Unmarshal([]byte(`{"usd":100,"USD":-250," Usd ":1}`), &ub); err != nil {
+		t.Fatal(err)
+	}
+
+	if m := ub.Get(USD); ub.Len() != 1 || m.Amount != -149 {
+		t.Errorf("Expected codes differing by case and spaces to add up to %d got %v", -149, ub.Totals())
+	}
+
+	if err := json.Unmarshal([]byte(`{"usd":9223372036854775807,"USD":1}`), &ub); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if m := ub.Get(USD); m.Amount != -149 {
+		t.Errorf("Expected failed unmarshaling to keep the totals got %v", m)
+	}
+}
+
+func TestBag_TotalsByCode(t *testing.T) {
+	b, _ := NewBag(New(1099, EUR), New(-250, "usd"))
+
+	ts := b.TotalsByCode()
+	if len(ts) != 2 || ts[EUR].Amount != 1099 || ts[USD].Amount != -250 {
+		t.Errorf("Unexpected totals %v", ts)
+	}
+
+	ts[EUR].Amount = 0
+	delete(ts, USD)
+	if b.Get(EUR).Amount != 1099 || b.Len() != 2 {
+		t.Error("Expected changes to the totals not to affect the bag")
+	}
+}
+
+func TestBag_ConvertAll(t *testing.T) {
+	b, _ := NewBag(New(1000, EUR), New(500, USD), New(15000, JPY))
+
+	m, err := b.ConvertAll(USD, testRates, RoundHalfUp)
+	if err != nil || m.Amount != 11580 || m.Currency.Code != USD {
+		t.Errorf("Expected %d %s got %v (%v)", 11580, USD, m, err)
+	}
+
+	var empty Bag
+	if m, err := empty.ConvertAll(EUR, testRates, RoundHalfUp); err != nil || !m.IsZero() || m.Currency.Code != EUR {
+		t.Errorf("Expected zero %s got %v (%v)", EUR, m, err)
+	}
+
+	b, _ = NewBag(New(100, GBP), New(100, USD))
+	if _, err := b.ConvertAll(USD, testRates, RoundHalfUp); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+
+	b, _ = NewBag(New(math.MaxInt64, USD), New(100, EUR))
+	if _, err := b.ConvertAll(USD, testRates, RoundHalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
@@ -0,0 +1,88 @@
+package money
+
+// NegativePolicy defines whether Balances may go below zero.
+type NegativePolicy int
+
+const (
+	// RejectNegative fails debits that would take a balance below zero with ErrInsufficientFunds.
+	RejectNegative NegativePolicy = iota
+	// AllowNegative lets balances go below zero, e.g. for accounts with an overdraft.
+	AllowNegative
+)
+
+// Balances holds an account balance per currency with checked credits and debits.
+// The zero value is an empty Balances rejecting negative balances, ready to use.
+// Balances is not safe for concurrent use.
+type Balances struct {
+	// Policy applies to every debit, it may be changed at any time.
+	Policy NegativePolicy
+
+	bag Bag
+}
+
+// NewBalances creates new empty Balances with given negative balance policy.
+func NewBalances(policy NegativePolicy) *Balances {
+	return &Balances{Policy: policy}
+}
+
+// Credit adds Money to the balance of its currency.
+// It returns ErrInvalidAmount for negative Money and ErrOverflow when the balance
+// doesn't fit into Amount, leaving the balance unchanged. Nil Money is ignored.
+func (b *Balances) Credit(m *Money) error {
+	if m != nil && m.IsNegative() {
+		return ErrInvalidAmount
+	}
+
+	return b.bag.Add(m)
+}
+
+// Debit subtracts Money from the balance of its currency.
+// It returns ErrInvalidAmount for negative Money, ErrInsufficientFunds when the balance
+// would go below zero under RejectNegative policy and ErrOverflow when the balance
+// doesn't fit into Amount, leaving the balance unchanged. Nil Money is ignored.
+func (b *Balances) Debit(m *Money) error {
+	if m == nil {
+		return nil
+	}
+
+	if m.IsNegative() {
+		return ErrInvalidAmount
+	}
+
+	a, ok := mutate.calc.subtractChecked(b.bag.Get(m.Currency.Code).Amount, m.Amount)
+	if !ok {
+		return ErrOverflow
+	}
+
+	if a < 0 && b.Policy == RejectNegative {
+		return ErrInsufficientFunds
+	}
+
+	return b.bag.Subtract(m)
+}
+
+// Get returns the balance of given currency, zero Money when there is none.
+func (b *Balances) Get(code string) *Money {
+	return b.bag.Get(code)
+}
+
+// Codes returns the sorted codes of currencies holding a balance.
+func (b *Balances) Codes() []string {
+	return b.bag.Codes()
+}
+
+// All returns all balances ordered by currency code.
+func (b *Balances) All() []*Money {
+	return b.bag.Totals()
+}
+
+// MarshalJSON implements json.Marshaler. Balances are encoded as an object of
+// amounts keyed by currency code, e.g. {"EUR":1099,"USD":250}. The policy isn't encoded.
+func (b *Balances) MarshalJSON() ([]byte, error) {
+	return b.bag.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The policy is kept as is.
+func (b *Balances) UnmarshalJSON(data []byte) error {
+	return b.bag.UnmarshalJSON(data)
+}
@@ -0,0 +1,88 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBalances(t *testing.T) {
+	var b Balances
+
+	if err := b.Credit(New(1000, EUR)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Debit(New(400, EUR)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Debit(New(601, EUR)); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("Expected %v got %v", ErrInsufficientFunds, err)
+	}
+
+	if err := b.Debit(New(1, USD)); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("Expected %v got %v", ErrInsufficientFunds, err)
+	}
+
+	if err := b.Credit(New(-1, EUR)); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected %v got %v", ErrInvalidAmount, err)
+	}
+
+	if err := b.Debit(New(-1, EUR)); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected %v got %v", ErrInvalidAmount, err)
+	}
+
+	if m := b.Get("eur"); m.Amount != 600 || m.Currency.Code != EUR {
+		t.Errorf("Expected %d %s got %v", 600, EUR, m)
+	}
+
+	if len(b.Codes()) != 1 {
+		t.Errorf("Expected %d codes got %v", 1, b.Codes())
+	}
+}
+
+func TestBalances_AllowNegative(t *testing.T) {
+	b := NewBalances(AllowNegative)
+
+	if err := b.Debit(New(250, USD)); err != nil {
+		t.Fatal(err)
+	}
+
+	if m := b.Get(USD); m.Amount != -250 {
+		t.Errorf("Expected %d got %d", -250, m.Amount)
+	}
+
+	if err := b.Debit(New(math.MaxInt64, USD)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if err := b.Credit(New(math.MaxInt64, EUR)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Credit(New(1, EUR)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestBalances_JSON(t *testing.T) {
+	b := NewBalances(RejectNegative)
+	_ = b.Credit(New(1099, EUR))
+	_ = b.Credit(New(250, USD))
+
+	data, err := json.Marshal(b)
+	if expected := `{"EUR":1099,"USD":250}`; err != nil || string(data) != expected {
+		t.Errorf("Expected %s got %s (%v)", expected, data, err)
+	}
+
+	var r Balances
+	if err := json.Unmarshal(data, &r); err != nil {
+		t.Fatal(err)
+	}
+
+	if !EqualSlices(r.All(), b.All()) {
+		t.Errorf("Expected %v got %v", amounts(b.All()), amounts(r.All()))
+	}
+}
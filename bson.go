@@ -0,0 +1,235 @@
+package money
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// BSON element types used by Money.
+const (
+	bsonDouble   = 0x01
+	bsonString   = 0x02
+	bsonDocument = 0x03
+	bsonInt32    = 0x10
+	bsonInt64    = 0x12
+)
+
+// MarshalBSON implements bson.Marshaler of the MongoDB driver without depending on it.
+// Money is encoded as a document of its amount in minor units as int64 and its currency
+// code, e.g. {amount: 1099, currency: "USD"}, with the fields always in this order. It
+// returns ErrNilCurrency for Money without Currency.
+func (m *Money) MarshalBSON() ([]byte, error) {
+	if m.Currency == nil {
+		return nil, ErrNilCurrency
+	}
+
+	code := canonicalCode(m.Currency.Code)
+
+	b := make([]byte, 4, 4+len("amount")+10+len("currency")+7+len(code)+1)
+	b = append(b, bsonInt64)
+	b = append(b, "amount\x00"...)
+	b = binary.LittleEndian.AppendUint64(b, uint64(m.Amount))
+	b = append(b, bsonString)
+	b = append(b, "currency\x00"...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(code)+1))
+	b = append(b, code...)
+	b = append(b, 0, 0)
+	binary.LittleEndian.PutUint32(b, uint32(len(b)))
+
+	return b, nil
+}
+
+// UnmarshalBSON implements bson.Unmarshaler of the MongoDB driver. Besides the document
+// written by MarshalBSON it accepts the one the driver writes from the struct tags of
+// Money, with the Currency as a nested document, and amounts stored as int32 or as
+// doubles holding whole minor units. Money is made Canonical. Malformed documents are
+// rejected with ErrInvalidEncoding, unknown codes as rejected by the UnknownCodePolicy.
+func (m *Money) UnmarshalBSON(data []byte) error {
+	var (
+		a Amount
+		c *Currency
+	)
+
+	err := walkBSON(data, func(t byte, key string, v []byte) error {
+		switch key {
+		case "amount":
+			switch t {
+			case bsonInt64:
+				a = Amount(binary.LittleEndian.Uint64(v))
+			case bsonInt32:
+				a = Amount(int32(binary.LittleEndian.Uint32(v)))
+			case bsonDouble:
+				f := math.Float64frombits(binary.LittleEndian.Uint64(v))
+				if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+					return fmt.Errorf("%w: amount %v", ErrInvalidEncoding, f)
+				}
+				a = Amount(f)
+			default:
+				return fmt.Errorf("%w: amount of type %#x", ErrInvalidEncoding, t)
+			}
+		case "currency":
+			switch t {
+			case bsonString:
+				code := bsonStringValue(v)
+				if code == "" {
+					return ErrNilCurrency
+				}
+
+				var err error
+				if c, err = resolveCode(code); err != nil {
+					return err
+				}
+			case bsonDocument:
+				dc := &Currency{}
+				if err := walkBSON(v, dc.setBSON); err != nil {
+					return err
+				}
+
+				if canonicalCode(dc.Code) == "" {
+					return ErrNilCurrency
+				}
+
+				var err error
+				if c, err = resolveDecoded(dc); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("%w: currency of type %#x", ErrInvalidEncoding, t)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	*m = *(&Money{Amount: a, Currency: c}).Canonical()
+
+	return nil
+}
+
+// setBSON sets a field of the Currency from an element of the document written from its
+// struct tags.
+func (c *Currency) setBSON(t byte, key string, v []byte) error {
+	if key == "fraction" {
+		switch t {
+		case bsonInt32:
+			c.Fraction = int(int32(binary.LittleEndian.Uint32(v)))
+		case bsonInt64:
+			c.Fraction = int(int64(binary.LittleEndian.Uint64(v)))
+		default:
+			return fmt.Errorf("%w: fraction of type %#x", ErrInvalidEncoding, t)
+		}
+
+		return nil
+	}
+
+	var f *string
+	switch key {
+	case "code":
+		f = &c.Code
+	case "numeric_code":
+		f = &c.NumericCode
+	case "grapheme":
+		f = &c.Grapheme
+	case "template":
+		f = &c.Template
+	case "decimal":
+		f = &c.Decimal
+	case "thousand":
+		f = &c.Thousand
+	default:
+		return nil
+	}
+
+	if t != bsonString {
+		return fmt.Errorf("%w: %s of type %#x", ErrInvalidEncoding, key, t)
+	}
+	*f = bsonStringValue(v)
+
+	return nil
+}
+
+// walkBSON calls fn with the type, key and value of each element of the BSON document.
+// String values are passed with their length prefix and documents as a whole, elements
+// of types Money doesn't use are skipped when their size is known.
+func walkBSON(doc []byte, fn func(t byte, key string, v []byte) error) error {
+	if len(doc) < 5 || int(binary.LittleEndian.Uint32(doc)) != len(doc) || doc[len(doc)-1] != 0 {
+		return fmt.Errorf("%w: malformed BSON document", ErrInvalidEncoding)
+	}
+
+	b := doc[4 : len(doc)-1]
+	for len(b) > 0 {
+		t := b[0]
+		b = b[1:]
+
+		n := 0
+		for n < len(b) && b[n] != 0 {
+			n++
+		}
+		if n == len(b) {
+			return fmt.Errorf("%w: unterminated BSON key", ErrInvalidEncoding)
+		}
+		key := string(b[:n])
+		b = b[n+1:]
+
+		size, err := bsonValueSize(t, b)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(t, key, b[:size]); err != nil {
+			return err
+		}
+		b = b[size:]
+	}
+
+	return nil
+}
+
+// bsonFixedSizes holds the sizes of BSON values which aren't length prefixed: double,
+// undefined, ObjectId, boolean, datetime, null, int32, timestamp, int64, decimal128,
+// max key and min key.
+var bsonFixedSizes = map[byte]int{
+	bsonDouble: 8, 0x06: 0, 0x07: 12, 0x08: 1, 0x09: 8, 0x0a: 0, bsonInt32: 4,
+	0x11: 8, bsonInt64: 8, 0x13: 16, 0x7f: 0, 0xff: 0,
+}
+
+// bsonValueSize returns the size of the value of given type at the start of b.
+func bsonValueSize(t byte, b []byte) (int, error) {
+	size, ok := bsonFixedSizes[t]
+	if !ok {
+		if len(b) < 4 {
+			return 0, fmt.Errorf("%w: truncated BSON value", ErrInvalidEncoding)
+		}
+
+		n := int(binary.LittleEndian.Uint32(b))
+		switch t {
+		case bsonString, 0x0d, 0x0e:
+			// Strings, JavaScript code and symbols are length prefixed and terminated.
+			if n < 1 || 4+n > len(b) || b[4+n-1] != 0 {
+				return 0, fmt.Errorf("%w: malformed BSON string", ErrInvalidEncoding)
+			}
+			size = 4 + n
+		case bsonDocument, 0x04:
+			size = n
+		case 0x05:
+			size = 4 + 1 + n
+		default:
+			return 0, fmt.Errorf("%w: unsupported BSON type %#x", ErrInvalidEncoding, t)
+		}
+	}
+
+	if size < 0 || size > len(b) {
+		return 0, fmt.Errorf("%w: truncated BSON value", ErrInvalidEncoding)
+	}
+
+	return size, nil
+}
+
+// bsonStringValue returns the string of a length prefixed BSON string value.
+func bsonStringValue(v []byte) string {
+	return string(v[4 : len(v)-1])
+}
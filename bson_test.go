@@ -0,0 +1,119 @@
+package money
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+)
+
+func bsonDoc(elems ...[]byte) []byte {
+	b := make([]byte, 4)
+	for _, e := range elems {
+		b = append(b, e...)
+	}
+	b = append(b, 0)
+	binary.LittleEndian.PutUint32(b, uint32(len(b)))
+
+	return b
+}
+
+func bsonElem(t byte, key string, v []byte) []byte {
+	return append(append([]byte{t}, key+"\x00"...), v...)
+}
+
+func bsonStr(s string) []byte {
+	return append(binary.LittleEndian.AppendUint32(nil, uint32(len(s)+1)), s+"\x00"...)
+}
+
+func bsonI64(v int64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, uint64(v))
+}
+
+func TestMoney_MarshalBSON(t *testing.T) {
+	b, err := New(-1099, "usd").MarshalBSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := bsonDoc(bsonElem(bsonInt64, "amount", bsonI64(-1099)), bsonElem(bsonString, "currency", bsonStr("USD")))
+	if string(b) != string(expected) {
+		t.Errorf("Expected %x got %x", expected, b)
+	}
+
+	var m Money
+	if err := m.UnmarshalBSON(b); err != nil || !m.EqualValue(New(-1099, USD)) {
+		t.Errorf("Expected %v got %v (%v)", New(-1099, USD), &m, err)
+	}
+
+	if _, err := (&Money{Amount: 1}).MarshalBSON(); !errors.Is(err, ErrNilCurrency) {
+		t.Errorf("Expected %v got %v", ErrNilCurrency, err)
+	}
+}
+
+func TestMoney_UnmarshalBSONShapes(t *testing.T) {
+	legacy := bsonDoc(
+		bsonElem(0x07, "_id", make([]byte, 12)),
+		bsonElem(bsonInt64, "amount", bsonI64(1099)),
+		bsonElem(bsonDocument, "currency", bsonDoc(
+			bsonElem(bsonString, "code", bsonStr("usd")),
+			bsonElem(bsonString, "numeric_code", bsonStr("840")),
+			bsonElem(bsonInt64, "fraction", bsonI64(2)),
+			bsonElem(bsonString, "grapheme", bsonStr("$")),
+			bsonElem(bsonString, "template", bsonStr("$1")),
+			bsonElem(bsonString, "decimal", bsonStr(".")),
+			bsonElem(bsonString, "thousand", bsonStr(",")),
+		)),
+		bsonElem(0x08, "paid", []byte{1}),
+	)
+
+	tcs := [][]byte{
+		legacy,
+		bsonDoc(bsonElem(bsonInt32, "amount", binary.LittleEndian.AppendUint32(nil, 1099)), bsonElem(bsonString, "currency", bsonStr("USD"))),
+		bsonDoc(bsonElem(bsonDouble, "amount", binary.LittleEndian.AppendUint64(nil, math.Float64bits(1099))), bsonElem(bsonString, "currency", bsonStr("USD"))),
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := m.UnmarshalBSON(tc); err != nil || !m.EqualValue(New(1099, USD)) {
+			t.Errorf("Expected %x to decode to %v got %v (%v)", tc, New(1099, USD), &m, err)
+		}
+	}
+}
+
+func TestMoney_UnmarshalBSONErrors(t *testing.T) {
+	valid := bsonDoc(bsonElem(bsonInt64, "amount", bsonI64(1)), bsonElem(bsonString, "currency", bsonStr("USD")))
+
+	tcs := []struct {
+		doc []byte
+		err error
+	}{
+		{nil, ErrInvalidEncoding},
+		{valid[:len(valid)-1], ErrInvalidEncoding},
+		{append(valid[:len(valid):len(valid)], 0), ErrInvalidEncoding},
+		{bsonDoc(bsonElem(bsonString, "amount", bsonStr("1"))), ErrInvalidEncoding},
+		{bsonDoc(bsonElem(bsonDouble, "amount", binary.LittleEndian.AppendUint64(nil, math.Float64bits(1.5)))), ErrInvalidEncoding},
+		{bsonDoc(bsonElem(bsonInt64, "currency", bsonI64(1))), ErrInvalidEncoding},
+		{bsonDoc(bsonElem(bsonString, "currency", bsonStr(""))), ErrNilCurrency},
+		{bsonDoc(bsonElem(bsonDocument, "currency", bsonDoc(bsonElem(bsonString, "grapheme", bsonStr("$"))))), ErrNilCurrency},
+		{bsonDoc(bsonElem(bsonString, "currency", []byte{9, 0, 0, 0, 'U', 0})), ErrInvalidEncoding},
+		{bsonDoc(bsonElem(0x0b, "pattern", []byte("a\x00\x00"))), ErrInvalidEncoding},
+		{bsonDoc([]byte{bsonInt64, 'a'}), ErrInvalidEncoding},
+	}
+
+	for i, tc := range tcs {
+		var m Money
+		if err := m.UnmarshalBSON(tc.doc); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for document %d got %v", tc.err, i, err)
+		}
+	}
+
+	defer SetUnknownCodePolicy(nil)
+	SetUnknownCodePolicy(UnknownCodeError())
+
+	doc := bsonDoc(bsonElem(bsonDocument, "currency", bsonDoc(bsonElem(bsonString, "code", bsonStr("XYZ")))))
+	var m Money
+	if err := m.UnmarshalBSON(doc); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+}
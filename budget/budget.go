@@ -0,0 +1,327 @@
+// Package budget implements envelope budgeting on top of money.Money. Incoming Money is
+// allocated across envelopes by priority and ratio until they reach their targets, funds
+// move between envelopes, and envelopes report whether they are under or over funded.
+package budget
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var (
+	// ErrEnvelopeExists happens when an envelope is added twice.
+	ErrEnvelopeExists = errors.New("budget: envelope already exists")
+
+	// ErrUnknownEnvelope happens when referring to an envelope which wasn't added.
+	ErrUnknownEnvelope = errors.New("budget: unknown envelope")
+)
+
+// Envelope configures an envelope of a Budget.
+type Envelope struct {
+	Name string
+	// Target is the amount the envelope is filled up to. Nil means the envelope takes
+	// whatever is allocated to it.
+	Target *money.Money
+	// Priority orders allocation: envelopes of lower priority values are filled first.
+	Priority int
+	// Ratio weights the envelope against the others of its priority, at least 1.
+	Ratio int64
+}
+
+// State tells how an envelope is funded against its target.
+type State int
+
+// States of an envelope.
+const (
+	Underfunded State = iota
+	Funded
+	Overfunded
+)
+
+var stateNames = [...]string{
+	Underfunded: "underfunded",
+	Funded:      "funded",
+	Overfunded:  "overfunded",
+}
+
+// String returns the name of the State.
+func (s State) String() string {
+	if s < 0 || int(s) >= len(stateNames) {
+		return "unknown"
+	}
+
+	return stateNames[s]
+}
+
+// Status is the state of an envelope as reported by Budget.Report.
+type Status struct {
+	Envelope Envelope
+	Balance  *money.Money
+	State    State
+	// Difference is the balance less the target, negative when underfunded and zero
+	// for envelopes without target.
+	Difference *money.Money
+}
+
+// Allocation is the Money allocated to an envelope by Budget.Allocate.
+type Allocation struct {
+	Envelope string
+	Amount   *money.Money
+}
+
+type envelope struct {
+	Envelope
+	balance money.Amount
+}
+
+// need returns how much the envelope takes to reach its target, -1 without target.
+func (e *envelope) need() money.Amount {
+	if e.Target == nil {
+		return -1
+	}
+
+	return max(e.Target.Amount-e.balance, 0)
+}
+
+// Budget holds envelopes of a single currency. Budget is safe for concurrent use.
+type Budget struct {
+	mu          sync.Mutex
+	zero        *money.Money
+	envelopes   []*envelope
+	unallocated money.Amount
+}
+
+// New creates new Budget of given currency.
+func New(code string) *Budget {
+	return &Budget{zero: money.New(0, code)}
+}
+
+// Add adds an envelope with zero balance. It returns ErrEnvelopeExists, and
+// money.ErrCurrencyMismatch or money.ErrInvalidAmount for an invalid target.
+func (b *Budget) Add(e Envelope) error {
+	if e.Target != nil {
+		if err := b.check(e.Target); err != nil {
+			return err
+		}
+	}
+	e.Ratio = max(e.Ratio, 1)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.find(e.Name) != nil {
+		return fmt.Errorf("%w: %s", ErrEnvelopeExists, e.Name)
+	}
+	b.envelopes = append(b.envelopes, &envelope{Envelope: e})
+
+	return nil
+}
+
+// Allocate allocates incoming Money across the envelopes and returns the allocations in
+// the order the envelopes were added. Envelopes are filled by ascending Priority. Within
+// a priority Money is split by Ratio, leftover minor units going to the envelopes added
+// first, and the share above the target of an envelope goes to the others. What's left
+// once all envelopes with target are full, and no envelope without target takes it,
+// stays unallocated. It returns money.ErrCurrencyMismatch, money.ErrInvalidAmount and
+// money.ErrOverflow when a balance doesn't fit into money.Amount, leaving the Budget
+// unchanged.
+func (b *Budget) Allocate(m *money.Money) ([]Allocation, error) {
+	if err := b.check(m); err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	given := make(map[*envelope]money.Amount)
+	remaining := m.Amount
+
+	groups := slices.Clone(b.envelopes)
+	slices.SortStableFunc(groups, func(x, y *envelope) int { return cmp.Compare(x.Priority, y.Priority) })
+
+	for start := 0; start < len(groups) && remaining > 0; {
+		end := start
+		for end < len(groups) && groups[end].Priority == groups[start].Priority {
+			end++
+		}
+
+		for remaining > 0 {
+			var active []*envelope
+			var ratios []int64
+			for _, e := range groups[start:end] {
+				if e.need() != 0 {
+					active = append(active, e)
+					ratios = append(ratios, e.Ratio)
+				}
+			}
+
+			if len(active) == 0 {
+				break
+			}
+
+			shares, err := (&money.Money{Amount: remaining, Currency: m.Currency}).AllocateInt64(ratios...)
+			if err != nil {
+				return nil, err
+			}
+
+			for i, e := range active {
+				s := shares[i].Amount
+				if n := e.need(); n >= 0 && s > n {
+					s = n
+				}
+
+				bal, err := b.add(e.balance, s)
+				if err != nil {
+					undo(given)
+					return nil, err
+				}
+
+				e.balance = bal
+				given[e] += s
+				remaining -= s
+			}
+		}
+
+		start = end
+	}
+	u, err := b.add(b.unallocated, remaining)
+	if err != nil {
+		undo(given)
+		return nil, err
+	}
+	b.unallocated = u
+
+	var as []Allocation
+	for _, e := range b.envelopes {
+		if a, ok := given[e]; ok && a != 0 {
+			as = append(as, Allocation{Envelope: e.Name, Amount: b.money(a)})
+		}
+	}
+
+	return as, nil
+}
+
+// Move moves Money from one envelope to another. It returns ErrUnknownEnvelope,
+// money.ErrInsufficientFunds when the envelope holds less than Money,
+// money.ErrCurrencyMismatch, money.ErrInvalidAmount and money.ErrOverflow.
+func (b *Budget) Move(from, to string, m *money.Money) error {
+	if err := b.check(m); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	src, dst := b.find(from), b.find(to)
+	switch {
+	case src == nil:
+		return fmt.Errorf("%w: %s", ErrUnknownEnvelope, from)
+	case dst == nil:
+		return fmt.Errorf("%w: %s", ErrUnknownEnvelope, to)
+	case src.balance < m.Amount:
+		return fmt.Errorf("%w: %s holds %s", money.ErrInsufficientFunds, from, b.money(src.balance).Display())
+	}
+
+	bal, err := b.add(dst.balance, m.Amount)
+	if err != nil {
+		return err
+	}
+
+	src.balance -= m.Amount
+	dst.balance = bal
+
+	return nil
+}
+
+// Balance returns the balance of the envelope. It returns ErrUnknownEnvelope.
+func (b *Budget) Balance(name string) (*money.Money, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e := b.find(name)
+	if e == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEnvelope, name)
+	}
+
+	return b.money(e.balance), nil
+}
+
+// Unallocated returns the Money allocated to no envelope.
+func (b *Budget) Unallocated() *money.Money {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.money(b.unallocated)
+}
+
+// Report returns the Status of every envelope in the order they were added.
+func (b *Budget) Report() []Status {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ss := make([]Status, 0, len(b.envelopes))
+	for _, e := range b.envelopes {
+		s := Status{Envelope: e.Envelope, Balance: b.money(e.balance), State: Funded, Difference: b.money(0)}
+		if e.Target != nil {
+			s.Difference = b.money(e.balance - e.Target.Amount)
+			switch {
+			case s.Difference.IsNegative():
+				s.State = Underfunded
+			case s.Difference.IsPositive():
+				s.State = Overfunded
+			}
+		}
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+func (b *Budget) find(name string) *envelope {
+	for _, e := range b.envelopes {
+		if e.Name == name {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (b *Budget) check(m *money.Money) error {
+	if _, err := b.zero.Compare(m); err != nil {
+		return err
+	}
+
+	if m.IsNegative() {
+		return money.ErrInvalidAmount
+	}
+
+	return nil
+}
+
+// add returns the sum of two amounts, or money.ErrOverflow when it doesn't fit into
+// money.Amount.
+func (b *Budget) add(x, y money.Amount) (money.Amount, error) {
+	m, err := b.money(x).AddChecked(b.money(y))
+	if err != nil {
+		return 0, err
+	}
+
+	return m.Amount, nil
+}
+
+// undo takes back the amounts given to the envelopes by a failed Allocate.
+func undo(given map[*envelope]money.Amount) {
+	for e, a := range given {
+		e.balance -= a
+	}
+}
+
+func (b *Budget) money(a money.Amount) *money.Money {
+	return &money.Money{Amount: a, Currency: b.zero.Currency}
+}
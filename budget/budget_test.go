@@ -0,0 +1,186 @@
+package budget
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func eur(amount int64) *money.Money {
+	return money.New(amount, money.EUR)
+}
+
+func newBudget(t *testing.T, es ...Envelope) *Budget {
+	b := New(money.EUR)
+	for _, e := range es {
+		if err := b.Add(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return b
+}
+
+func checkAllocations(t *testing.T, as []Allocation, expected map[string]money.Amount) {
+	t.Helper()
+
+	if len(as) != len(expected) {
+		t.Errorf("Expected %d allocations got %v", len(expected), as)
+	}
+
+	for _, a := range as {
+		if a.Amount.Amount != expected[a.Envelope] {
+			t.Errorf("Expected %d allocated to %s got %d", expected[a.Envelope], a.Envelope, a.Amount.Amount)
+		}
+	}
+}
+
+func TestBudget_Allocate(t *testing.T) {
+	b := newBudget(t,
+		Envelope{Name: "rent", Target: eur(100000)},
+		Envelope{Name: "groceries", Target: eur(40000), Priority: 1, Ratio: 2},
+		Envelope{Name: "fun", Target: eur(10000), Priority: 1},
+		Envelope{Name: "savings", Priority: 2},
+	)
+
+	as, err := b.Allocate(eur(120000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkAllocations(t, as, map[string]money.Amount{"rent": 100000, "groceries": 13334, "fun": 6666})
+
+	as, err = b.Allocate(eur(50000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkAllocations(t, as, map[string]money.Amount{"groceries": 26666, "fun": 3334, "savings": 20000})
+
+	for _, s := range b.Report() {
+		if s.State != Funded || !s.Difference.IsZero() {
+			t.Errorf("Expected %s to be funded got %s by %v", s.Envelope.Name, s.State, s.Difference.Amount)
+		}
+	}
+
+	if u := b.Unallocated(); !u.IsZero() {
+		t.Errorf("Expected nothing unallocated got %v", u.Amount)
+	}
+}
+
+func TestBudget_Unallocated(t *testing.T) {
+	b := newBudget(t, Envelope{Name: "rent", Target: eur(1000)})
+
+	if _, err := b.Allocate(eur(1500)); err != nil {
+		t.Fatal(err)
+	}
+
+	if u := b.Unallocated(); u.Amount != 500 {
+		t.Errorf("Expected %d unallocated got %d", 500, u.Amount)
+	}
+}
+
+func TestBudget_Move(t *testing.T) {
+	b := newBudget(t,
+		Envelope{Name: "groceries", Target: eur(400)},
+		Envelope{Name: "fun", Target: eur(100)},
+	)
+
+	if _, err := b.Allocate(eur(500)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Move("fun", "groceries", eur(30)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		state      State
+		difference money.Amount
+	}{
+		{Overfunded, 30},
+		{Underfunded, -30},
+	}
+
+	for i, s := range b.Report() {
+		if s.State != expected[i].state || s.Difference.Amount != expected[i].difference {
+			t.Errorf("Expected %s to be %s by %d got %s by %d", s.Envelope.Name, expected[i].state, expected[i].difference, s.State, s.Difference.Amount)
+		}
+	}
+
+	if m, _ := b.Balance("fun"); m.Amount != 70 {
+		t.Errorf("Expected balance %d got %d", 70, m.Amount)
+	}
+
+	tcs := []struct {
+		from, to string
+		m        *money.Money
+		err      error
+	}{
+		{"fun", "groceries", eur(71), money.ErrInsufficientFunds},
+		{"rent", "groceries", eur(1), ErrUnknownEnvelope},
+		{"fun", "rent", eur(1), ErrUnknownEnvelope},
+		{"fun", "groceries", money.New(1, money.USD), money.ErrCurrencyMismatch},
+		{"fun", "groceries", eur(-1), money.ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		if err := b.Move(tc.from, tc.to, tc.m); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v got %v", tc.err, err)
+		}
+	}
+}
+
+func TestBudget_Overflow(t *testing.T) {
+	b := newBudget(t, Envelope{Name: "rent", Target: eur(1000)}, Envelope{Name: "savings", Priority: 1})
+
+	if _, err := b.Allocate(eur(math.MaxInt64 - 1000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Move("rent", "savings", eur(500)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := b.Allocate(eur(2001)); !errors.Is(err, money.ErrOverflow) {
+		t.Errorf("Expected %v got %v", money.ErrOverflow, err)
+	}
+
+	rent, _ := b.Balance("rent")
+	savings, _ := b.Balance("savings")
+	if rent.Amount != 500 || savings.Amount != math.MaxInt64-1500 {
+		t.Errorf("Expected failed allocation to leave the balances got %d and %d", rent.Amount, savings.Amount)
+	}
+
+	if _, err := b.Allocate(eur(1400)); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Move("rent", "savings", eur(1000)); !errors.Is(err, money.ErrOverflow) {
+		t.Errorf("Expected %v got %v", money.ErrOverflow, err)
+	}
+
+	if m, _ := b.Balance("rent"); m.Amount != 1000 {
+		t.Errorf("Expected failed move to leave the balance got %d", m.Amount)
+	}
+
+	u := newBudget(t, Envelope{Name: "rent", Target: eur(1)})
+	_, _ = u.Allocate(eur(math.MaxInt64))
+	if _, err := u.Allocate(eur(2)); !errors.Is(err, money.ErrOverflow) || u.Unallocated().Amount != math.MaxInt64-1 {
+		t.Errorf("Expected %v and unchanged unallocated got %v %d", money.ErrOverflow, err, u.Unallocated().Amount)
+	}
+}
+
+func TestBudget_Add(t *testing.T) {
+	b := newBudget(t, Envelope{Name: "rent"})
+
+	if err := b.Add(Envelope{Name: "rent"}); !errors.Is(err, ErrEnvelopeExists) {
+		t.Errorf("Expected %v got %v", ErrEnvelopeExists, err)
+	}
+
+	if err := b.Add(Envelope{Name: "fun", Target: money.New(1, money.USD)}); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", money.ErrCurrencyMismatch, err)
+	}
+
+	if _, err := b.Balance("fun"); !errors.Is(err, ErrUnknownEnvelope) {
+		t.Errorf("Expected %v got %v", ErrUnknownEnvelope, err)
+	}
+}
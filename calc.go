@@ -0,0 +1,198 @@
+package money
+
+import (
+	"math/big"
+	"sync/atomic"
+)
+
+// Calculator performs the arithmetic of Money methods like Add, Multiply, Round, Split
+// and Allocate, so it can be instrumented, e.g. for audit trails, or replaced, e.g. by a
+// fixed-point backend. Methods returning a boolean report false when the result doesn't
+// fit into Amount; the Money methods then apply the active Policy. Calculators must be
+// safe for concurrent use. Wrap DefaultCalculator to change only some of the operations.
+//
+// Aggregates like Sum and Bag, and the bookkeeping of leftover pennies, keep using the
+// built-in arithmetic.
+type Calculator interface {
+	// Add returns a + b.
+	Add(a, b Amount) (Amount, bool)
+	// Subtract returns a - b.
+	Subtract(a, b Amount) (Amount, bool)
+	// Multiply returns a * m.
+	Multiply(a Amount, m int64) (Amount, bool)
+	// MultiplyRat returns a * r rounded to a whole Amount with given mode.
+	MultiplyRat(a Amount, r *big.Rat, mode RoundingMode) (Amount, bool)
+	// Divide returns a / d truncated towards zero, d is positive.
+	Divide(a Amount, d int64) Amount
+	// Modulus returns the remainder of Divide, having the sign of a.
+	Modulus(a Amount, d int64) Amount
+	// Allocate returns a * r / s truncated towards zero, where r <= s and s is positive.
+	Allocate(a Amount, r, s uint64) Amount
+	// Absolute returns the absolute value of a.
+	Absolute(a Amount) (Amount, bool)
+	// Negative returns the negative absolute value of a.
+	Negative(a Amount) Amount
+	// Round returns a rounded to a multiple of 10^e with given mode.
+	Round(a Amount, e int, mode RoundingMode) (Amount, bool)
+}
+
+// builtinCalculator exposes the package calculator as Calculator.
+type builtinCalculator struct{}
+
+func (builtinCalculator) Add(a, b Amount) (Amount, bool) {
+	return mutate.calc.addChecked(a, b)
+}
+
+func (builtinCalculator) Subtract(a, b Amount) (Amount, bool) {
+	return mutate.calc.subtractChecked(a, b)
+}
+
+func (builtinCalculator) Multiply(a Amount, m int64) (Amount, bool) {
+	return mutate.calc.multiplyChecked(a, m)
+}
+
+func (builtinCalculator) MultiplyRat(a Amount, r *big.Rat, mode RoundingMode) (Amount, bool) {
+	return mutate.calc.multiplyRat(a, r, mode)
+}
+
+func (builtinCalculator) Divide(a Amount, d int64) Amount {
+	return mutate.calc.divide(a, d)
+}
+
+func (builtinCalculator) Modulus(a Amount, d int64) Amount {
+	return mutate.calc.modulus(a, d)
+}
+
+func (builtinCalculator) Allocate(a Amount, r, s uint64) Amount {
+	return mutate.calc.allocate64(a, r, s)
+}
+
+func (builtinCalculator) Absolute(a Amount) (Amount, bool) {
+	return mutate.calc.absoluteChecked(a)
+}
+
+func (builtinCalculator) Negative(a Amount) Amount {
+	return mutate.calc.negative(a)
+}
+
+func (builtinCalculator) Round(a Amount, e int, mode RoundingMode) (Amount, bool) {
+	// Ties towards zero have a faster path than the general rounding.
+	if mode == RoundHalfDown {
+		return mutate.calc.roundChecked(a, e)
+	}
+
+	return mutate.calc.roundModeChecked(a, e, mode)
+}
+
+// DefaultCalculator returns the built-in Calculator, computing with int64 and reporting
+// overflows rather than wrapping around.
+func DefaultCalculator() Calculator {
+	return builtinCalculator{}
+}
+
+var calculatorOverride atomic.Pointer[Calculator]
+
+// SetCalculator sets the Calculator used by Money methods. Nil restores
+// DefaultCalculator. It is safe to call concurrently with operations, but is meant to be
+// called once during program initialization.
+func SetCalculator(c Calculator) {
+	if c == nil {
+		calculatorOverride.Store(nil)
+		return
+	}
+
+	calculatorOverride.Store(&c)
+}
+
+// GetCalculator returns the Calculator used by Money methods.
+func GetCalculator() Calculator {
+	if c := calculatorOverride.Load(); c != nil {
+		return *c
+	}
+
+	return builtinCalculator{}
+}
+
+// The functions below back the Money methods. Unless SetCalculator was called they use
+// the built-in calculator directly, so the default path compiles to plain integer
+// operations and only a replaced Calculator is called through the interface.
+
+func calcAdd(a, b Amount) (Amount, bool) {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).Add(a, b)
+	}
+
+	return mutate.calc.addChecked(a, b)
+}
+
+func calcSubtract(a, b Amount) (Amount, bool) {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).Subtract(a, b)
+	}
+
+	return mutate.calc.subtractChecked(a, b)
+}
+
+func calcMultiply(a Amount, m int64) (Amount, bool) {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).Multiply(a, m)
+	}
+
+	return mutate.calc.multiplyChecked(a, m)
+}
+
+func calcMultiplyRat(a Amount, r *big.Rat, mode RoundingMode) (Amount, bool) {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).MultiplyRat(a, r, mode)
+	}
+
+	return mutate.calc.multiplyRat(a, r, mode)
+}
+
+func calcDivide(a Amount, d int64) Amount {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).Divide(a, d)
+	}
+
+	return mutate.calc.divide(a, d)
+}
+
+func calcModulus(a Amount, d int64) Amount {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).Modulus(a, d)
+	}
+
+	return mutate.calc.modulus(a, d)
+}
+
+func calcAllocate(a Amount, r, s uint64) Amount {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).Allocate(a, r, s)
+	}
+
+	return mutate.calc.allocate64(a, r, s)
+}
+
+func calcAbsolute(a Amount) (Amount, bool) {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).Absolute(a)
+	}
+
+	return mutate.calc.absoluteChecked(a)
+}
+
+func calcNegative(a Amount) Amount {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).Negative(a)
+	}
+
+	return mutate.calc.negative(a)
+}
+
+func calcRound(a Amount, e int, mode RoundingMode) (Amount, bool) {
+	if c := calculatorOverride.Load(); c != nil {
+		return (*c).Round(a, e, mode)
+	}
+
+	return builtinCalculator{}.Round(a, e, mode)
+}
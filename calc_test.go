@@ -0,0 +1,148 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+// auditCalculator records the operations it performs on top of DefaultCalculator.
+type auditCalculator struct {
+	Calculator
+
+	mu  sync.Mutex
+	ops []string
+}
+
+func (c *auditCalculator) record(op string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ops = append(c.ops, op)
+}
+
+func (c *auditCalculator) Add(a, b Amount) (Amount, bool) {
+	c.record("add")
+	return c.Calculator.Add(a, b)
+}
+
+func (c *auditCalculator) Multiply(a Amount, m int64) (Amount, bool) {
+	c.record("multiply")
+	return c.Calculator.Multiply(a, m)
+}
+
+func (c *auditCalculator) Divide(a Amount, d int64) Amount {
+	c.record("divide")
+	return c.Calculator.Divide(a, d)
+}
+
+func (c *auditCalculator) Allocate(a Amount, r, s uint64) Amount {
+	c.record("allocate")
+	return c.Calculator.Allocate(a, r, s)
+}
+
+func (c *auditCalculator) Round(a Amount, e int, mode RoundingMode) (Amount, bool) {
+	c.record("round " + mode.String())
+	return c.Calculator.Round(a, e, mode)
+}
+
+func TestSetCalculator(t *testing.T) {
+	defer SetCalculator(nil)
+
+	c := &auditCalculator{Calculator: DefaultCalculator()}
+	SetCalculator(c)
+
+	if GetCalculator() != Calculator(c) {
+		t.Fatal("Expected the set Calculator")
+	}
+
+	m := New(1000, EUR)
+	if r, err := m.Add(New(1, EUR)); err != nil || r.Amount != 1001 {
+		t.Errorf("Expected %d got %v (%v)", 1001, r, err)
+	}
+	m.Multiply(3)
+	m.Round()
+	if _, err := m.RoundWithMode(RoundHalfEven); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Split(3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Allocate(1, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"add", "multiply", "round half_down", "round half_even", "divide", "allocate", "allocate"}
+	if len(c.ops) != len(expected) {
+		t.Fatalf("Expected operations %v got %v", expected, c.ops)
+	}
+	for i := range expected {
+		if c.ops[i] != expected[i] {
+			t.Errorf("Expected operations %v got %v", expected, c.ops)
+			break
+		}
+	}
+
+	SetCalculator(nil)
+	if _, ok := GetCalculator().(builtinCalculator); !ok {
+		t.Errorf("Expected the default Calculator got %T", GetCalculator())
+	}
+}
+
+// overflowCalculator reports every addition as overflowing.
+type overflowCalculator struct {
+	Calculator
+}
+
+func (overflowCalculator) Add(a, b Amount) (Amount, bool) {
+	return 0, false
+}
+
+func TestSetCalculator_Overflow(t *testing.T) {
+	defer SetCalculator(nil)
+	SetCalculator(overflowCalculator{DefaultCalculator()})
+
+	if _, err := New(1, EUR).Add(New(1, EUR)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if New(1, EUR).CanAdd(New(1, EUR)) {
+		t.Error("Expected CanAdd to follow the Calculator")
+	}
+
+	defer SetPolicy(PolicyError)
+	SetPolicy(PolicySaturate)
+
+	if r, err := New(1, EUR).Add(New(1, EUR)); err != nil || r.Amount != MaxAmount {
+		t.Errorf("Expected %d got %v (%v)", MaxAmount, r, err)
+	}
+}
+
+func TestDefaultCalculator(t *testing.T) {
+	c := DefaultCalculator()
+
+	if _, ok := c.Add(math.MaxInt64, 1); ok {
+		t.Error("Expected Add to overflow")
+	}
+
+	if _, ok := c.Absolute(math.MinInt64); ok {
+		t.Error("Expected Absolute to overflow")
+	}
+
+	if a, ok := c.MultiplyRat(10, big.NewRat(1, 4), RoundHalfEven); !ok || a != 2 {
+		t.Errorf("Expected %d got %d", 2, a)
+	}
+
+	if a := c.Allocate(-10, 1, 3); a != -3 {
+		t.Errorf("Expected %d got %d", -3, a)
+	}
+
+	for _, a := range []Amount{-150, -149, -50, 0, 49, 50, 150, 151, MaxAmount, MinAmount} {
+		fast, fok := c.Round(a, 2, RoundHalfDown)
+		r, ok := mutate.calc.roundModeChecked(a, 2, RoundHalfDown)
+		if fast != r || fok != ok {
+			t.Errorf("Expected %d rounded half down to be %d (%t) got %d (%t)", a, r, ok, fast, fok)
+		}
+	}
+}
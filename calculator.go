@@ -1,6 +1,10 @@
 package money
 
-import "math"
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
 
 type calculator struct{}
 
@@ -8,28 +12,71 @@ func (c *calculator) add(a, b Amount) Amount {
 	return a + b
 }
 
-func (c *calculator) subtract(a, b Amount) Amount {
-	return a - b
+// addChecked returns the sum of a and b and whether it didn't overflow.
+func (c *calculator) addChecked(a, b Amount) (Amount, bool) {
+	s := a + b
+	return s, (s > a) == (b > 0)
 }
 
-func (c *calculator) multiply(a Amount, m int64) Amount {
-	return a * m
+// subtractChecked returns the difference of a and b and whether it didn't overflow.
+func (c *calculator) subtractChecked(a, b Amount) (Amount, bool) {
+	d := a - b
+	return d, (d < a) == (b > 0)
+}
+
+// multiplyChecked returns the product of a and m and whether it didn't overflow.
+func (c *calculator) multiplyChecked(a Amount, m int64) (Amount, bool) {
+	if a == 0 || m == 0 {
+		return 0, true
+	}
+
+	p := a * Amount(m)
+	return p, p/Amount(m) == a && !(m == -1 && a == math.MinInt64)
+}
+
+// multiplyRat returns a multiplied by r rounded with given mode and whether it didn't
+// overflow. The product is computed exactly before rounding.
+func (c *calculator) multiplyRat(a Amount, r *big.Rat, mode RoundingMode) (Amount, bool) {
+	p := roundQuo(new(big.Int).Mul(big.NewInt(int64(a)), r.Num()), r.Denom(), mode)
+	if !p.IsInt64() {
+		return 0, false
+	}
+
+	return Amount(p.Int64()), true
 }
 
 func (c *calculator) divide(a Amount, d int64) Amount {
-	return a / d
+	return a / Amount(d)
 }
 
 func (c *calculator) modulus(a Amount, d int64) Amount {
-	return a % d
+	return a % Amount(d)
 }
 
 func (c *calculator) allocate(a Amount, r, s uint) Amount {
+	return c.allocate64(a, uint64(r), uint64(s))
+}
+
+// allocate64 returns a * r / s truncated towards zero for r <= s, computing the
+// product with 128 bits so it never overflows.
+func (c *calculator) allocate64(a Amount, r, s uint64) Amount {
 	if a == 0 || s == 0 {
 		return 0
 	}
 
-	return a * int64(r) / int64(s)
+	ua := uint64(a)
+	if a < 0 {
+		ua = -ua
+	}
+
+	hi, lo := bits.Mul64(ua, r)
+	q, _ := bits.Div64(hi, lo, s)
+
+	if a < 0 {
+		return -Amount(q)
+	}
+
+	return Amount(q)
 }
 
 func (c *calculator) absolute(a Amount) Amount {
@@ -40,6 +87,12 @@ func (c *calculator) absolute(a Amount) Amount {
 	return a
 }
 
+// absoluteChecked returns the absolute value of a and whether it didn't overflow,
+// which only MinInt64 does.
+func (c *calculator) absoluteChecked(a Amount) (Amount, bool) {
+	return c.absolute(a), a != math.MinInt64
+}
+
 func (c *calculator) negative(a Amount) Amount {
 	if a > 0 {
 		return -a
@@ -49,25 +102,47 @@ func (c *calculator) negative(a Amount) Amount {
 }
 
 func (c *calculator) round(a Amount, e int) Amount {
+	r, _ := c.roundChecked(a, e)
+	return r
+}
+
+// roundModeChecked returns a rounded to a multiple of 10^e with given rounding mode and
+// whether it didn't overflow.
+func (c *calculator) roundModeChecked(a Amount, e int, mode RoundingMode) (Amount, bool) {
+	p := scale(e)
+	r := roundQuo(big.NewInt(int64(a)), p, mode)
+	r.Mul(r, p)
+	if !r.IsInt64() {
+		return 0, false
+	}
+
+	return Amount(r.Int64()), true
+}
+
+// roundChecked returns a rounded to a multiple of 10^e and whether it didn't overflow.
+func (c *calculator) roundChecked(a Amount, e int) (Amount, bool) {
 	if a == 0 {
-		return 0
+		return 0, true
 	}
 
-	absam := c.absolute(a)
-	exp := int64(math.Pow(10, float64(e)))
+	// Work with the magnitude as uint64, so MinInt64 has one.
+	absam := uint64(a)
+	if a < 0 {
+		absam = -absam
+	}
+
+	exp := uint64(math.Pow(10, float64(e)))
 	m := absam % exp
+	absam -= m
 
+	ok := true
 	if m > (exp / 2) {
-		absam += exp
+		absam, ok = absam+exp, absam <= math.MaxUint64-exp
 	}
 
-	absam = (absam / exp) * exp
-
 	if a < 0 {
-		a = -absam
-	} else {
-		a = absam
+		return -Amount(absam), ok && absam <= 1<<63
 	}
 
-	return a
+	return Amount(absam), ok && absam <= math.MaxInt64
 }
@@ -0,0 +1,24 @@
+package money
+
+// Canonical returns Money with its currency code trimmed and upper-cased, so Money
+// coming from different upstreams, e.g. "usd" and "USD", compares, keys and dedupes
+// the same. Currency missing its grapheme or template is resolved from the registry.
+func (m *Money) Canonical() *Money {
+	c := m.Currency
+	if c == nil {
+		return &Money{Amount: m.Amount}
+	}
+
+	code := canonicalCode(c.Code)
+	if !c.resolved() && GetCurrency(code) != nil {
+		return &Money{Amount: m.Amount, Currency: c.get().clone()}
+	}
+
+	if code != c.Code {
+		cc := *c
+		cc.Code = code
+		c = &cc
+	}
+
+	return &Money{Amount: m.Amount, Currency: c}
+}
@@ -0,0 +1,54 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMoney_Canonical(t *testing.T) {
+	tcs := []struct {
+		m        *Money
+		expected string
+	}{
+		{&Money{Amount: 100, Currency: &Currency{Code: "usd"}}, "USD:100"},
+		{&Money{Amount: 100, Currency: &Currency{Code: " eur\n"}}, "EUR:100"},
+		{New(100, " gbp"), "GBP:100"},
+		{&Money{Amount: 5, Currency: &Currency{Code: "pts", Fraction: 0}}, "PTS:5"},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.m.Canonical(); r.Key() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r.Key())
+		}
+	}
+
+	if r := (&Money{Amount: 1, Currency: &Currency{Code: "usd"}}).Canonical(); *r.Currency != *GetCurrency(USD) {
+		t.Errorf("Expected registered %s got %+v", USD, r.Currency)
+	}
+}
+
+func TestMoney_UnmarshalJSON(t *testing.T) {
+	var a, b Money
+	_ = json.Unmarshal([]byte(`{"Amount":1099,"Currency":{"code":"usd"}}`), &a)
+	_ = json.Unmarshal([]byte(`{"Amount":1099,"Currency":{"code":"USD"}}`), &b)
+
+	if ok, err := a.Equals(&b); !ok || err != nil || a.Display() != "$10.99" {
+		t.Errorf("Expected %v to equal %v got %s (%v)", a, b, a.Display(), err)
+	}
+
+	m := New(1, EUR)
+	if err := json.Unmarshal([]byte(`{"Amount":10.5,"Currency":{"code":"USD"}}`), m); !errors.Is(err, ErrInvalidJSONUnmarshal) {
+		t.Errorf("Expected %v got %v", ErrInvalidJSONUnmarshal, err)
+	}
+
+	data, err := json.Marshal(New(-250, USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var r Money
+	if err := json.Unmarshal(data, &r); err != nil || r.Amount != -250 || r.Currency.Code != USD {
+		t.Errorf("Expected round trip of %s got %v (%v)", data, r, err)
+	}
+}
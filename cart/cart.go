@@ -0,0 +1,328 @@
+// Package cart computes shopping cart totals on top of money.Money. Totals are computed
+// in a fixed order with a single rounding per step, so the same cart always produces the
+// same breakdown and the lines always add up to the totals:
+//
+//  1. The subtotal of each line is its unit price times its quantity, which is exact.
+//  2. Item discounts are applied to their line in the order given. A percentage
+//     discount is taken of the line amount left by the previous discounts and rounded
+//     with the Rounding of the Cart.
+//  3. Cart discounts are applied in the order given. A percentage discount is taken of
+//     the sum of all lines left by the previous discounts and rounded once, then the
+//     discount is split between the lines in proportion to their amounts, leftover
+//     minor units going to the lines with the largest remainders.
+//  4. Taxes are computed per line of its amount after all discounts and rounded per
+//     line. Shipping isn't discounted and is taxed with the ShippingTaxRate.
+//  5. The totals are the sums of the lines, shipping and taxes.
+//
+// Discounts never take a line below zero; a discount higher than what it applies to is
+// capped to it.
+package cart
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var (
+	// ErrInvalidQuantity happens when an Item has a quantity below one.
+	ErrInvalidQuantity = errors.New("cart: quantity must be positive")
+
+	// ErrInvalidRate happens when a discount rate isn't within [0, 1] or a tax rate is negative.
+	ErrInvalidRate = errors.New("cart: invalid rate")
+
+	// ErrInvalidDiscount happens when a Discount has both or neither of a rate and an amount.
+	ErrInvalidDiscount = errors.New("cart: discount needs either a rate or an amount")
+)
+
+// Discount takes either a percentage or a fixed amount off what it applies to.
+type Discount struct {
+	// Rate is the fraction taken off, e.g. 1/10 for 10% off.
+	Rate *big.Rat
+	// Amount is the fixed Money taken off.
+	Amount *money.Money
+}
+
+// PercentOff returns a Discount taking given percentage off, e.g. 10 for 10% off.
+func PercentOff(percent int64) Discount {
+	return Discount{Rate: big.NewRat(percent, 100)}
+}
+
+// AmountOff returns a Discount taking given Money off.
+func AmountOff(m *money.Money) Discount {
+	return Discount{Amount: m}
+}
+
+// Item is a line of a Cart.
+type Item struct {
+	SKU       string
+	Price     *money.Money
+	Quantity  int64
+	Discounts []Discount
+	// TaxRate is the fraction of the discounted line amount added as tax, e.g. 1/5
+	// for 20% VAT. Nil means the Item isn't taxed.
+	TaxRate *big.Rat
+}
+
+// Cart holds the items, discounts and shipping to compute totals of. All Money must be
+// of the Currency of the Cart.
+type Cart struct {
+	Currency  string
+	Items     []Item
+	Discounts []Discount
+	Shipping  *money.Money
+	// ShippingTaxRate is the fraction of Shipping added as tax, nil means untaxed shipping.
+	ShippingTaxRate *big.Rat
+	// Rounding is used by percentage discounts and taxes.
+	Rounding money.RoundingMode
+}
+
+// Line is the breakdown of a single Item.
+type Line struct {
+	Item Item
+	// Subtotal is the price of the Item times its quantity.
+	Subtotal *money.Money
+	// ItemDiscount is the sum of the discounts of the Item.
+	ItemDiscount *money.Money
+	// CartDiscount is the share of the cart discounts taken off the line.
+	CartDiscount *money.Money
+	// Net is Subtotal less all discounts.
+	Net *money.Money
+	// Tax is the tax of Net.
+	Tax *money.Money
+	// Total is Net plus Tax.
+	Total *money.Money
+}
+
+// Breakdown is the result of Cart.Totals.
+type Breakdown struct {
+	Lines []Line
+	// Subtotal is the sum of the subtotals of the lines.
+	Subtotal *money.Money
+	// Discount is the sum of all item and cart discounts.
+	Discount *money.Money
+	// Net is the sum of the lines after discounts.
+	Net *money.Money
+	// Shipping is the shipping of the Cart, zero when it has none.
+	Shipping *money.Money
+	// ShippingTax is the tax of Shipping.
+	ShippingTax *money.Money
+	// Tax is the sum of the taxes of the lines and ShippingTax.
+	Tax *money.Money
+	// Total is Net plus Shipping plus Tax, the amount to pay.
+	Total *money.Money
+}
+
+// Totals computes the Breakdown of the Cart. It returns ErrInvalidQuantity,
+// ErrInvalidRate or ErrInvalidDiscount for invalid items and discounts,
+// money.ErrNilMoney, money.ErrInvalidAmount for negative Money,
+// money.ErrCurrencyMismatch for Money of other Currency and money.ErrOverflow when
+// an amount doesn't fit into money.Amount.
+func (c *Cart) Totals() (*Breakdown, error) {
+	zero, err := money.NewWithOptions(0, c.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Breakdown{Lines: make([]Line, len(c.Items)), Subtotal: zero, Discount: zero, Net: zero, Shipping: zero, ShippingTax: zero, Tax: zero, Total: zero}
+
+	// Steps 1 and 2: line subtotals and item discounts.
+	nets := make([]*money.Money, len(c.Items))
+	for i, it := range c.Items {
+		if err := check(zero, it.Price); err != nil {
+			return nil, fmt.Errorf("item %q: %w", it.SKU, err)
+		}
+
+		if it.Quantity < 1 {
+			return nil, fmt.Errorf("%w: item %q", ErrInvalidQuantity, it.SKU)
+		}
+
+		if !it.Price.CanMultiply(it.Quantity) {
+			return nil, fmt.Errorf("item %q: %w", it.SKU, money.ErrOverflow)
+		}
+
+		l := Line{Item: it, Subtotal: it.Price.Multiply(it.Quantity), ItemDiscount: zero, CartDiscount: zero}
+		net := l.Subtotal
+		for _, d := range it.Discounts {
+			off, err := c.discount(zero, net, d)
+			if err != nil {
+				return nil, fmt.Errorf("item %q: %w", it.SKU, err)
+			}
+
+			net, _ = net.Subtract(off)
+			l.ItemDiscount, _ = l.ItemDiscount.Add(off)
+		}
+
+		b.Lines[i] = l
+		nets[i] = net
+	}
+
+	// Step 3: cart discounts.
+	for _, d := range c.Discounts {
+		net, err := sum(zero, nets)
+		if err != nil {
+			return nil, err
+		}
+
+		off, err := c.discount(zero, net, d)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(nets) == 0 {
+			continue
+		}
+
+		// Nothing was refunded before, so the refund is split by the largest remainders
+		// in proportion to the lines, which never takes a line below zero.
+		shares, err := money.AllocateRefund(nets, nil, off)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, s := range shares {
+			nets[i], _ = nets[i].Subtract(s)
+			b.Lines[i].CartDiscount, _ = b.Lines[i].CartDiscount.Add(s)
+		}
+	}
+
+	// Steps 4 and 5: taxes and totals.
+	for i := range b.Lines {
+		l := &b.Lines[i]
+		l.Net = nets[i]
+
+		var err error
+		if l.Tax, err = c.tax(l.Net, l.Item.TaxRate); err != nil {
+			return nil, fmt.Errorf("item %q: %w", l.Item.SKU, err)
+		}
+
+		if l.Total, err = l.Net.Add(l.Tax); err != nil {
+			return nil, err
+		}
+
+		discount, _ := l.ItemDiscount.Add(l.CartDiscount)
+		if err := add(&b.Subtotal, l.Subtotal); err != nil {
+			return nil, err
+		}
+		if err := add(&b.Discount, discount); err != nil {
+			return nil, err
+		}
+		if err := add(&b.Net, l.Net); err != nil {
+			return nil, err
+		}
+		if err := add(&b.Tax, l.Tax); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Shipping != nil {
+		if err := check(zero, c.Shipping); err != nil {
+			return nil, fmt.Errorf("shipping: %w", err)
+		}
+		b.Shipping = c.Shipping
+
+		var err error
+		if b.ShippingTax, err = c.tax(c.Shipping, c.ShippingTaxRate); err != nil {
+			return nil, fmt.Errorf("shipping: %w", err)
+		}
+
+		if err := add(&b.Tax, b.ShippingTax); err != nil {
+			return nil, err
+		}
+	}
+
+	total, err := sum(zero, []*money.Money{b.Net, b.Shipping, b.Tax})
+	if err != nil {
+		return nil, err
+	}
+	b.Total = total
+
+	return b, nil
+}
+
+// discount returns the Money the Discount takes off base, capped to base.
+func (c *Cart) discount(zero, base *money.Money, d Discount) (*money.Money, error) {
+	var off *money.Money
+	switch {
+	case (d.Rate == nil) == (d.Amount == nil):
+		return nil, ErrInvalidDiscount
+	case d.Rate != nil:
+		if d.Rate.Sign() < 0 || d.Rate.Cmp(big.NewRat(1, 1)) > 0 {
+			return nil, fmt.Errorf("%w: discount of %s", ErrInvalidRate, d.Rate.RatString())
+		}
+
+		var err error
+		if off, err = percentOf(base, d.Rate, c.Rounding); err != nil {
+			return nil, err
+		}
+	default:
+		if err := check(zero, d.Amount); err != nil {
+			return nil, err
+		}
+		off = d.Amount
+	}
+
+	if off.Amount > base.Amount {
+		return base, nil
+	}
+
+	return off, nil
+}
+
+// tax returns the tax of given Money, zero for a nil rate.
+func (c *Cart) tax(m *money.Money, rate *big.Rat) (*money.Money, error) {
+	if rate == nil {
+		return &money.Money{Currency: m.Currency}, nil
+	}
+
+	if rate.Sign() < 0 {
+		return nil, fmt.Errorf("%w: tax of %s", ErrInvalidRate, rate.RatString())
+	}
+
+	return percentOf(m, rate, c.Rounding)
+}
+
+// percentOf returns the fraction rate of Money rounded with given mode.
+func percentOf(m *money.Money, rate *big.Rat, mode money.RoundingMode) (*money.Money, error) {
+	return (&money.Money{Currency: m.Currency}).SetRat(new(big.Rat).Mul(m.AsRat(), rate), mode)
+}
+
+// check validates Money given to a Cart of the Currency of zero.
+func check(zero, m *money.Money) error {
+	if !m.IsValid() {
+		return money.ErrNilMoney
+	}
+
+	if _, err := zero.Compare(m); err != nil {
+		return err
+	}
+
+	if m.IsNegative() {
+		return money.ErrInvalidAmount
+	}
+
+	return nil
+}
+
+func add(total **money.Money, m *money.Money) error {
+	t, err := (*total).Add(m)
+	if err != nil {
+		return err
+	}
+	*total = t
+
+	return nil
+}
+
+func sum(zero *money.Money, ms []*money.Money) (*money.Money, error) {
+	total := zero
+	for _, m := range ms {
+		if err := add(&total, m); err != nil {
+			return nil, err
+		}
+	}
+
+	return total, nil
+}
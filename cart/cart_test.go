@@ -0,0 +1,152 @@
+package cart
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var vat = big.NewRat(1, 5)
+
+func eur(amount int64) *money.Money {
+	return money.New(amount, money.EUR)
+}
+
+func TestCart_Totals(t *testing.T) {
+	c := &Cart{
+		Currency: money.EUR,
+		Items: []Item{
+			{SKU: "shirt", Price: eur(1999), Quantity: 3, Discounts: []Discount{PercentOff(10)}, TaxRate: vat},
+			{SKU: "socks", Price: eur(500), Quantity: 1, Discounts: []Discount{AmountOff(eur(100))}, TaxRate: vat},
+		},
+		Discounts:       []Discount{PercentOff(10)},
+		Shipping:        eur(499),
+		ShippingTaxRate: vat,
+	}
+
+	b, err := c.Totals()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := []struct {
+		subtotal, itemDiscount, cartDiscount, net, tax, total money.Amount
+	}{
+		{5997, 600, 540, 4857, 971, 5828},
+		{500, 100, 40, 360, 72, 432},
+	}
+
+	for i, e := range lines {
+		l := b.Lines[i]
+		got := []money.Amount{l.Subtotal.Amount, l.ItemDiscount.Amount, l.CartDiscount.Amount, l.Net.Amount, l.Tax.Amount, l.Total.Amount}
+		expected := []money.Amount{e.subtotal, e.itemDiscount, e.cartDiscount, e.net, e.tax, e.total}
+		for j := range got {
+			if got[j] != expected[j] {
+				t.Errorf("Expected line %d to be %v got %v", i, expected, got)
+				break
+			}
+		}
+	}
+
+	totals := []struct {
+		name     string
+		m        *money.Money
+		expected money.Amount
+	}{
+		{"subtotal", b.Subtotal, 6497},
+		{"discount", b.Discount, 1280},
+		{"net", b.Net, 5217},
+		{"shipping", b.Shipping, 499},
+		{"shipping tax", b.ShippingTax, 100},
+		{"tax", b.Tax, 1143},
+		{"total", b.Total, 6859},
+	}
+
+	for _, tc := range totals {
+		if tc.m.Amount != tc.expected {
+			t.Errorf("Expected %s to be %d got %d", tc.name, tc.expected, tc.m.Amount)
+		}
+	}
+}
+
+func TestCart_TotalsCapped(t *testing.T) {
+	c := &Cart{
+		Currency: money.EUR,
+		Items: []Item{
+			{SKU: "a", Price: eur(300), Quantity: 1, Discounts: []Discount{AmountOff(eur(500))}},
+			{SKU: "b", Price: eur(1), Quantity: 3},
+			{SKU: "c", Price: eur(1), Quantity: 3},
+		},
+		Discounts: []Discount{AmountOff(eur(5)), AmountOff(eur(5))},
+	}
+
+	b, err := c.Totals()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []money.Amount{0, 0, 0}
+	for i, l := range b.Lines {
+		if l.Net.Amount != expected[i] {
+			t.Errorf("Expected net of line %d to be %d got %d", i, expected[i], l.Net.Amount)
+		}
+	}
+
+	if b.Discount.Amount != 306 || b.Total.Amount != 0 {
+		t.Errorf("Expected discount %d and total %d got %d and %d", 306, 0, b.Discount.Amount, b.Total.Amount)
+	}
+}
+
+func TestCart_TotalsRounding(t *testing.T) {
+	item := Item{SKU: "a", Price: eur(5), Quantity: 1, TaxRate: big.NewRat(1, 2)}
+
+	tcs := []struct {
+		mode     money.RoundingMode
+		expected money.Amount
+	}{
+		{money.RoundHalfUp, 3},
+		{money.RoundHalfEven, 2},
+		{money.RoundDown, 2},
+	}
+
+	for _, tc := range tcs {
+		c := &Cart{Currency: money.EUR, Items: []Item{item}, Rounding: tc.mode}
+		if b, err := c.Totals(); err != nil || b.Tax.Amount != tc.expected {
+			t.Errorf("Expected tax %d rounding %s got %v (%v)", tc.expected, tc.mode, b, err)
+		}
+	}
+}
+
+func TestCart_TotalsErrors(t *testing.T) {
+	tcs := []struct {
+		cart Cart
+		err  error
+	}{
+		{Cart{Items: []Item{{Price: eur(1), Quantity: 0}}}, ErrInvalidQuantity},
+		{Cart{Items: []Item{{Price: eur(-1), Quantity: 1}}}, money.ErrInvalidAmount},
+		{Cart{Items: []Item{{Quantity: 1}}}, money.ErrNilMoney},
+		{Cart{Items: []Item{{Price: money.New(1, money.USD), Quantity: 1}}}, money.ErrCurrencyMismatch},
+		{Cart{Items: []Item{{Price: eur(1), Quantity: 1, Discounts: []Discount{{}}}}}, ErrInvalidDiscount},
+		{Cart{Items: []Item{{Price: eur(1), Quantity: 1, Discounts: []Discount{PercentOff(101)}}}}, ErrInvalidRate},
+		{Cart{Items: []Item{{Price: eur(1), Quantity: 1, TaxRate: big.NewRat(-1, 5)}}}, ErrInvalidRate},
+		{Cart{Items: []Item{{Price: eur(int64(money.MaxAmount)), Quantity: 2}}}, money.ErrOverflow},
+		{Cart{Discounts: []Discount{AmountOff(money.New(1, money.USD))}}, money.ErrCurrencyMismatch},
+		{Cart{Shipping: eur(-1)}, money.ErrInvalidAmount},
+	}
+
+	for i, tc := range tcs {
+		tc.cart.Currency = money.EUR
+		if _, err := tc.cart.Totals(); !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for cart %d got %v", tc.err, i, err)
+		}
+	}
+
+	money.SetUnknownCodePolicy(money.UnknownCodeError())
+	defer money.SetUnknownCodePolicy(nil)
+
+	if _, err := (&Cart{Currency: "UNK"}).Totals(); !errors.Is(err, money.ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", money.ErrUnsupportedCurrency, err)
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"maps"
+	"math/big"
+	"sync"
+	"sync/atomic"
+)
+
+// CashRounding is the statutory rule rounding cash payments of a currency, whose smallest
+// coins are withdrawn or never existed.
+type CashRounding struct {
+	// Increment is the amount cash payments are rounded to a multiple of, in minor units
+	// of the registered Currency, e.g. 5 for CHF 0.05.
+	Increment Amount
+	// Mode rounds amounts between two multiples of Increment.
+	Mode RoundingMode
+}
+
+// The cash rounding table is swapped atomically like the currency registry.
+var (
+	cashRoundingsMu sync.Mutex
+	cashRoundings   atomic.Pointer[map[string]CashRounding]
+)
+
+func init() {
+	rs := map[string]CashRounding{
+		AUD: {Increment: 5, Mode: RoundHalfUp},
+		CAD: {Increment: 5, Mode: RoundHalfUp},
+		CHF: {Increment: 5, Mode: RoundHalfUp},
+		CZK: {Increment: 100, Mode: RoundHalfUp},
+		DKK: {Increment: 50, Mode: RoundHalfUp},
+		HUF: {Increment: 500, Mode: RoundHalfUp},
+		NOK: {Increment: 100, Mode: RoundHalfUp},
+		NZD: {Increment: 10, Mode: RoundHalfUp},
+		SEK: {Increment: 100, Mode: RoundHalfUp},
+	}
+	cashRoundings.Store(&rs)
+}
+
+// GetCashRounding returns the cash rounding rule of given currency and whether it has one.
+func GetCashRounding(code string) (CashRounding, bool) {
+	r, ok := (*cashRoundings.Load())[canonicalCode(code)]
+	return r, ok
+}
+
+// RegisterCashRounding sets the cash rounding rule of given currency, e.g. when a country
+// withdraws its smallest coins. An Increment below two removes the rule of the currency.
+func RegisterCashRounding(code string, r CashRounding) {
+	cashRoundingsMu.Lock()
+	defer cashRoundingsMu.Unlock()
+
+	rs := maps.Clone(*cashRoundings.Load())
+	if r.Increment < 2 {
+		delete(rs, canonicalCode(code))
+	} else {
+		rs[canonicalCode(code)] = r
+	}
+	cashRoundings.Store(&rs)
+}
+
+// RoundCash returns new Money struct with value rounded by the cash rounding rule of its
+// currency, e.g. CHF 12.33 to CHF 12.35 and SEK 12.50 to SEK 13. Money of currencies
+// without rule is returned unchanged. Amounts whose rounding doesn't fit into Amount are
+// rounded towards zero instead.
+func (m *Money) RoundCash() *Money {
+	r, ok := GetCashRounding(m.Currency.Code)
+	if !ok {
+		return &Money{Amount: m.Amount, Currency: m.Currency}
+	}
+
+	// The increment is given in minor units of the registered Currency, Money may hold
+	// more fraction digits, e.g. after Rescale.
+	inc := r.Increment
+	if rc := GetCurrency(m.Currency.Code); rc != nil {
+		var err error
+		if inc, err = rescaleAmount(inc, rc.Fraction, m.Currency.get().Fraction); err != nil || inc < 2 {
+			return &Money{Amount: m.Amount, Currency: m.Currency}
+		}
+	}
+
+	q := roundQuo(big.NewInt(int64(m.Amount)), big.NewInt(int64(inc)), r.Mode)
+	a, ok := mutate.calc.multiplyChecked(Amount(q.Int64()), int64(inc))
+	if !ok {
+		a = m.Amount - m.Amount%inc
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}
+}
@@ -0,0 +1,55 @@
+package money
+
+import "testing"
+
+func TestMoney_RoundCash(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		expected Amount
+	}{
+		{New(1233, CHF), 1235},
+		{New(1232, CHF), 1230},
+		{New(-1233, CHF), -1235},
+		{New(1232, AUD), 1230},
+		{New(1238, AUD), 1240},
+		{New(1215, NZD), 1220},
+		{New(1214, NZD), 1210},
+		{New(1250, SEK), 1300},
+		{New(1249, SEK), 1200},
+		{New(1224, DKK), 1200},
+		{New(1225, DKK), 1250},
+		{New(12249, HUF), 12000},
+		{New(12250, HUF), 12500},
+		{New(1233, EUR), 1233},
+		{New(int64(MaxAmount), CHF), MaxAmount - MaxAmount%5},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.money.RoundCash(); r.Amount != tc.expected || !r.SameCurrency(tc.money) {
+			t.Errorf("Expected %d for %s got %d", tc.expected, tc.money.Display(), r.Amount)
+		}
+	}
+
+	r, _ := New(1233, CHF).Rescale(4, RoundHalfUp)
+	if c := r.RoundCash(); c.Amount != 123500 {
+		t.Errorf("Expected %d for rescaled %s got %d", 123500, r.Display(), c.Amount)
+	}
+}
+
+func TestRegisterCashRounding(t *testing.T) {
+	defer RegisterCashRounding(EUR, CashRounding{})
+
+	RegisterCashRounding("eur", CashRounding{Increment: 5, Mode: RoundHalfEven})
+	if r, ok := GetCashRounding(EUR); !ok || r.Increment != 5 {
+		t.Errorf("Expected EUR rule got %v (%v)", r, ok)
+	}
+
+	if c := New(1233, EUR).RoundCash(); c.Amount != 1235 {
+		t.Errorf("Expected %d got %d", 1235, c.Amount)
+	}
+
+	RegisterCashRounding(EUR, CashRounding{})
+	if _, ok := GetCashRounding(EUR); ok {
+		t.Errorf("Expected EUR rule to be removed")
+	}
+}
@@ -0,0 +1,68 @@
+package money
+
+// The checked operations below return ErrOverflow whenever a result doesn't fit into
+// Amount, regardless of the active Policy, for code that must never wrap around or
+// saturate, e.g. when aggregating high volumes or amounts of currencies with many
+// fraction digits. Split and Allocate never overflow, they compute with 128 bits.
+
+// AddChecked works like Add but returns ErrOverflow when the sum doesn't fit into Amount.
+func (m *Money) AddChecked(om *Money) (*Money, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return nil, err
+	}
+
+	a, ok := calcAdd(m.Amount, om.Amount)
+	if !ok {
+		return nil, ErrOverflow
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}, nil
+}
+
+// SubtractChecked works like Subtract but returns ErrOverflow when the difference
+// doesn't fit into Amount.
+func (m *Money) SubtractChecked(om *Money) (*Money, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return nil, err
+	}
+
+	a, ok := calcSubtract(m.Amount, om.Amount)
+	if !ok {
+		return nil, ErrOverflow
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}, nil
+}
+
+// MultiplyChecked works like Multiply but returns ErrOverflow when the product doesn't
+// fit into Amount.
+func (m *Money) MultiplyChecked(mul int64) (*Money, error) {
+	a, ok := calcMultiply(m.Amount, mul)
+	if !ok {
+		return nil, ErrOverflow
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}, nil
+}
+
+// AbsoluteChecked works like Absolute but returns ErrOverflow for MinAmount, whose
+// absolute value doesn't fit into Amount.
+func (m *Money) AbsoluteChecked() (*Money, error) {
+	a, ok := calcAbsolute(m.Amount)
+	if !ok {
+		return nil, ErrOverflow
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}, nil
+}
+
+// RoundChecked works like Round but returns ErrOverflow when rounding goes past the
+// limits of Amount.
+func (m *Money) RoundChecked() (*Money, error) {
+	a, ok := calcRound(m.Amount, m.Currency.Fraction, RoundHalfDown)
+	if !ok {
+		return nil, ErrOverflow
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}, nil
+}
@@ -0,0 +1,72 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoney_Checked(t *testing.T) {
+	defer SetPolicy(PolicyError)
+
+	max, min := New(int64(MaxAmount), USD), New(int64(MinAmount), USD)
+
+	tcs := []struct {
+		name     string
+		op       func() (*Money, error)
+		expected Amount
+		err      error
+	}{
+		{"add", func() (*Money, error) { return New(1, USD).AddChecked(New(2, USD)) }, 3, nil},
+		{"add overflow", func() (*Money, error) { return max.AddChecked(New(1, USD)) }, 0, ErrOverflow},
+		{"add mismatch", func() (*Money, error) { return max.AddChecked(New(1, EUR)) }, 0, ErrCurrencyMismatch},
+		{"subtract", func() (*Money, error) { return New(1, USD).SubtractChecked(New(2, USD)) }, -1, nil},
+		{"subtract overflow", func() (*Money, error) { return min.SubtractChecked(New(1, USD)) }, 0, ErrOverflow},
+		{"multiply", func() (*Money, error) { return New(-3, USD).MultiplyChecked(4) }, -12, nil},
+		{"multiply overflow", func() (*Money, error) { return max.MultiplyChecked(2) }, 0, ErrOverflow},
+		{"multiply min", func() (*Money, error) { return min.MultiplyChecked(-1) }, 0, ErrOverflow},
+		{"absolute", func() (*Money, error) { return New(-3, USD).AbsoluteChecked() }, 3, nil},
+		{"absolute overflow", func() (*Money, error) { return min.AbsoluteChecked() }, 0, ErrOverflow},
+		{"round", func() (*Money, error) { return New(-1251, USD).RoundChecked() }, -1300, nil},
+		{"round max", func() (*Money, error) { return max.RoundChecked() }, MaxAmount - 7, nil},
+		{"round overflow", func() (*Money, error) { return New(int64(MaxAmount), KWD).RoundChecked() }, 0, ErrOverflow},
+		{"round min", func() (*Money, error) { return min.RoundChecked() }, MinAmount + 8, nil},
+	}
+
+	// Checked operations fail regardless of the Policy.
+	for _, p := range []Policy{PolicyError, PolicySaturate} {
+		SetPolicy(p)
+
+		for _, tc := range tcs {
+			m, err := tc.op()
+			if !errors.Is(err, tc.err) || (err == nil && m.Amount != tc.expected) {
+				t.Errorf("Expected %s to return %d (%v) got %v (%v)", tc.name, tc.expected, tc.err, m, err)
+			}
+		}
+	}
+}
+
+func TestMoney_AbsoluteRoundPolicy(t *testing.T) {
+	defer SetPolicy(PolicyError)
+
+	min := New(int64(MinAmount), USD)
+	if m := min.Absolute(); m.Amount != MinAmount {
+		t.Errorf("Expected wrap around to %d got %d", MinAmount, m.Amount)
+	}
+
+	SetPolicy(PolicySaturate)
+	if m := min.Absolute(); m.Amount != MaxAmount {
+		t.Errorf("Expected saturation to %d got %d", MaxAmount, m.Amount)
+	}
+
+	if m := New(int64(MaxAmount), KWD).Round(); m.Amount != MaxAmount {
+		t.Errorf("Expected saturation to %d got %d", MaxAmount, m.Amount)
+	}
+
+	SetPolicy(PolicyPanic)
+	defer func() {
+		if r := recover(); r != ErrOverflow {
+			t.Errorf("Expected panic with %v got %v", ErrOverflow, r)
+		}
+	}()
+	min.Absolute()
+}
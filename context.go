@@ -0,0 +1,77 @@
+package money
+
+import "context"
+
+// Config holds money behavior which may vary per request, e.g. per tenant of a server.
+// The zero value is the default behavior of the package.
+type Config struct {
+	// Rounding is the rounding mode used where an operation has to round, defaults to the
+	// one set with SetDefaultRounding when nil.
+	Rounding *RoundingMode
+
+	// Strict rejects currency codes unknown to the package with ErrUnsupportedCurrency.
+	Strict bool
+
+	// Formatter returns the Formatter used to display Money of given Currency,
+	// e.g. to follow the locale of the request. Defaults to Currency.Formatter.
+	Formatter func(c *Currency) *Formatter
+}
+
+type configKey struct{}
+
+// WithConfig returns a copy of ctx carrying given Config.
+func WithConfig(ctx context.Context, cfg Config) context.Context {
+	return context.WithValue(ctx, configKey{}, cfg)
+}
+
+// ConfigFrom returns the Config carried by ctx, the default Config when there is none.
+func ConfigFrom(ctx context.Context) Config {
+	cfg, _ := ctx.Value(configKey{}).(Config)
+	return cfg
+}
+
+// rounding returns the RoundingMode of the Config.
+func (cfg Config) rounding() RoundingMode {
+	if cfg.Rounding != nil {
+		return *cfg.Rounding
+	}
+
+	return GetDefaultRounding()
+}
+
+// formatter returns the Formatter of given Currency according to the Config.
+func (cfg Config) formatter(c *Currency) *Formatter {
+	if cfg.Formatter != nil {
+		return cfg.Formatter(c)
+	}
+
+	return c.Formatter()
+}
+
+// NewContext creates and returns new instance of Money according to the Config carried by ctx.
+// Under a Strict Config it returns UnsupportedCurrencyError for unknown currency codes,
+// otherwise the error of the UnknownCodePolicy rejecting them.
+func NewContext(ctx context.Context, amount int64, code string) (*Money, error) {
+	if ConfigFrom(ctx).Strict {
+		return NewWithOptions(amount, code, WithStrictCode())
+	}
+
+	return NewWithOptions(amount, code)
+}
+
+// NewFromFloatContext is like NewFromFloatChecked, using the rounding mode and strictness
+// of the Config carried by ctx.
+func NewFromFloatContext(ctx context.Context, amount float64, code string) (*Money, error) {
+	cfg := ConfigFrom(ctx)
+	if cfg.Strict && GetCurrency(code) == nil {
+		return nil, &UnsupportedCurrencyError{Code: code}
+	}
+
+	return NewFromFloatChecked(amount, code, cfg.rounding())
+}
+
+// DisplayContext is like Display, using the Formatter of the Config carried by ctx.
+func (m *Money) DisplayContext(ctx context.Context) string {
+	c := m.Currency.get()
+	return ConfigFrom(ctx).formatter(c).Format(int64(m.Amount))
+}
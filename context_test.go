@@ -0,0 +1,60 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestConfigFrom(t *testing.T) {
+	if cfg := ConfigFrom(context.Background()); cfg.Rounding != nil || cfg.Strict || cfg.Formatter != nil {
+		t.Errorf("Expected default Config got %+v", cfg)
+	}
+
+	down := RoundDown
+	ctx := WithConfig(context.Background(), Config{Rounding: &down, Strict: true})
+	if cfg := ConfigFrom(ctx); cfg.rounding() != RoundDown || !cfg.Strict {
+		t.Errorf("Expected Config %+v got %+v", Config{Rounding: &down, Strict: true}, cfg)
+	}
+}
+
+func TestNewContext(t *testing.T) {
+	ctx := WithConfig(context.Background(), Config{Strict: true})
+
+	if _, err := NewContext(ctx, 100, "XYZ"); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+
+	if m, err := NewContext(context.Background(), 100, "XYZ"); err != nil || m.Currency.Code != "XYZ" {
+		t.Errorf("Expected %s got %v (%v)", "XYZ", m, err)
+	}
+
+	down := RoundDown
+	m, err := NewFromFloatContext(WithConfig(ctx, Config{Rounding: &down}), 1.239, EUR)
+	if err != nil || m.Amount != 123 {
+		t.Errorf("Expected %d got %v (%v)", 123, m, err)
+	}
+
+	defer SetDefaultRounding(RoundHalfUp)
+	SetDefaultRounding(RoundUp)
+
+	if m, err := NewFromFloatContext(context.Background(), 1.231, EUR); err != nil || m.Amount != 124 {
+		t.Errorf("Expected default rounding to apply got %v (%v)", m, err)
+	}
+}
+
+func TestMoney_DisplayContext(t *testing.T) {
+	m := New(123456, EUR)
+	if r := m.DisplayContext(context.Background()); r != m.Display() {
+		t.Errorf("Expected %s got %s", m.Display(), r)
+	}
+
+	german := func(c *Currency) *Formatter {
+		return NewFormatter(c.Fraction, ",", ".", c.Grapheme, "1 $")
+	}
+
+	ctx := WithConfig(context.Background(), Config{Formatter: german})
+	if r, expected := m.DisplayContext(ctx), "1.234,56 €"; r != expected {
+		t.Errorf("Expected %s got %s", expected, r)
+	}
+}
@@ -1,6 +1,7 @@
 package money
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -30,7 +31,7 @@ func (c Currencies) CurrencyByNumericCode(code string) *Currency {
 
 // CurrencyByCode returns the Currency given the Currency code defined as a constant.
 func (c Currencies) CurrencyByCode(code string) *Currency {
-	sc, ok := c[strings.ToUpper(code)]
+	sc, ok := c[canonicalCode(code)]
 	if !ok {
 		return nil
 	}
@@ -40,11 +41,12 @@ func (c Currencies) CurrencyByCode(code string) *Currency {
 
 // Add updates currencies list by adding a given Currency to it.
 func (c Currencies) Add(currency *Currency) Currencies {
-	c[currency.Code] = currency
+	c[canonicalCode(currency.Code)] = currency
 	return c
 }
 
-// currencies represents a collection of Currency.
+// currencies represents the collection of Currency embedded in the package, see DatasetLegacy.
+// It is never modified, lookups go through the active registry instead.
 var currencies = Currencies{
 	AED: {Decimal: ".", Thousand: ",", Code: AED, Fraction: 2, NumericCode: "784", Grapheme: ".\u062f.\u0625", Template: "1 $"},
 	AFN: {Decimal: ".", Thousand: ",", Code: AFN, Fraction: 2, NumericCode: "971", Grapheme: "\u060b", Template: "1 $"},
@@ -221,32 +223,122 @@ var currencies = Currencies{
 	ZWL: {Decimal: ".", Thousand: ",", Code: ZWL, Fraction: 2, NumericCode: "932", Grapheme: "Z$", Template: "$1"},
 }
 
-// AddCurrency lets you insert or update Currency in currencies list.
+// AddCurrency lets you insert or update Currency in the active currencies list.
+// The code is trimmed and upper-cased like in all lookups, so "eur" and "EUR" are
+// the same currency. It is safe to call concurrently with lookups. The returned Currency
+// is the registered one, GetCurrency returns it until the code is added again.
 func AddCurrency(code, Grapheme, Template, Decimal, Thousand string, Fraction int) *Currency {
 	c := Currency{
-		Code:     code,
+		Code:     canonicalCode(code),
 		Grapheme: Grapheme,
 		Template: Template,
 		Decimal:  Decimal,
 		Thousand: Thousand,
 		Fraction: Fraction,
 	}
-	currencies.Add(&c)
-	return &c
+	updateRegistry(func(cs Currencies) Currencies { return cs.Add(&c) })
+	return c.clone()
+}
+
+// RegisterCurrency adds a user-defined Currency to the active currencies list, e.g. for
+// loyalty points or codes missing from ISO 4217, so New, GetCurrency and formatting pick
+// it up. An empty Decimal defaults to "." and an empty Template to "1 $". It returns
+// ErrCurrencyExists when the code is registered already, use OverrideCurrency to replace
+// it, and ErrInvalidCurrency for an empty code or one holding spaces, ':' or '|', a
+// fraction outside of [0, 18], a Template without "1" or equal separators. It is safe to
+// call concurrently with lookups; the Currency is copied.
+func RegisterCurrency(c Currency) error {
+	return registerCurrency(c, false)
+}
+
+// OverrideCurrency works like RegisterCurrency but replaces the Currency of given code
+// when it's registered already, e.g. to display JPY with another grapheme. The code of c
+// defaults to the given one and must match it otherwise. Money created before keeps the
+// Currency it was created with.
+func OverrideCurrency(code string, c Currency) error {
+	if c.Code == "" {
+		c.Code = code
+	}
+
+	if canonicalCode(c.Code) != canonicalCode(code) {
+		return fmt.Errorf("%w: code %q doesn't match %q", ErrInvalidCurrency, c.Code, code)
+	}
+
+	return registerCurrency(c, true)
+}
+
+func registerCurrency(c Currency, override bool) error {
+	c.Code = canonicalCode(c.Code)
+	if c.Decimal == "" {
+		c.Decimal = "."
+	}
+	if c.Template == "" {
+		c.Template = "1 $"
+	}
+
+	if err := c.validate(); err != nil {
+		return err
+	}
+
+	var err error
+	updateRegistry(func(cs Currencies) Currencies {
+		if !override && cs[c.Code] != nil {
+			err = fmt.Errorf("%w: %s", ErrCurrencyExists, c.Code)
+			return cs
+		}
+
+		return cs.Add(&c)
+	})
+
+	return err
+}
+
+// validate checks a Currency about to be registered.
+func (c *Currency) validate() error {
+	switch {
+	case c.Code == "" || strings.ContainsAny(c.Code, ":| \t\n"):
+		return fmt.Errorf("%w: code %q", ErrInvalidCurrency, c.Code)
+	case c.Fraction < 0 || c.Fraction > 18:
+		return fmt.Errorf("%w: fraction %d of %s", ErrInvalidCurrency, c.Fraction, c.Code)
+	case !strings.Contains(c.Template, "1"):
+		return fmt.Errorf("%w: template %q of %s", ErrInvalidCurrency, c.Template, c.Code)
+	case c.Decimal == c.Thousand:
+		return fmt.Errorf("%w: same decimal and thousand separator of %s", ErrInvalidCurrency, c.Code)
+	}
+
+	return nil
 }
 
 func newCurrency(code string) *Currency {
-	return &Currency{Code: strings.ToUpper(code)}
+	return &Currency{Code: canonicalCode(code)}
+}
+
+// canonicalCode returns the currency code trimmed and upper-cased, the form codes are
+// registered and compared in.
+func canonicalCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
 }
 
-// GetCurrency returns the Currency given the code.
+// GetCurrency returns the Currency given the code. It returns a copy of the registered
+// Currency, so modifying it affects neither the registry nor any Money.
 func GetCurrency(code string) *Currency {
-	return currencies.CurrencyByCode(strings.ToUpper(code))
+	return loadRegistry().CurrencyByCode(code).clone()
+}
+
+// clone returns a copy of the Currency, or nil for nil Currency.
+func (c *Currency) clone() *Currency {
+	if c == nil {
+		return nil
+	}
+
+	cc := *c
+	return &cc
 }
 
 // Formatter returns Currency formatter representing
-// used Currency structure.
+// used Currency structure. Currency holding only a code is resolved first.
 func (c *Currency) Formatter() *Formatter {
+	c = c.get()
 	return &Formatter{
 		Fraction: c.Fraction,
 		Decimal:  c.Decimal,
@@ -259,22 +351,73 @@ func (c *Currency) Formatter() *Formatter {
 // getDefault represent default Currency if Currency is not found in currencies list.
 // Grapheme and Code fields will be changed by Currency code.
 func (c *Currency) getDefault() *Currency {
-	return &Currency{Decimal: ".", Thousand: ",", Code: c.Code, Fraction: 2, Grapheme: c.Code, Template: "1$"}
+	code := canonicalCode(c.Code)
+	return &Currency{Decimal: ".", Thousand: ",", Code: code, Fraction: 2, Grapheme: code, Template: "1$"}
 }
 
-// get extended Currency using currencies list.
+// get extended Currency using the active registry snapshot.
 func (c *Currency) get() *Currency {
 	if c == nil {
-		return c.getDefault()
+		return (&Currency{}).getDefault()
+	}
+
+	// Resolved Currency is used as is, so Money keeps formatting the same way regardless
+	// of later registry updates.
+	if c.resolved() {
+		return c
+	}
+
+	rc := loadRegistry().CurrencyByCode(c.Code)
+	if rc == nil {
+		rc = c.getDefault()
+	}
+
+	if *c == (Currency{Code: c.Code}) {
+		return rc
 	}
 
-	if curr, ok := currencies[c.Code]; ok {
-		return curr
+	return c.fill(rc)
+}
+
+// resolved reports whether Currency carries everything needed for formatting, e.g. when
+// taken from the registry as Money was created or customized with constructor options.
+func (c *Currency) resolved() bool {
+	return c.Grapheme != "" && c.Template != ""
+}
+
+// fill returns a copy of the partially filled Currency, e.g. decoded from JSON holding
+// only its code and fraction, with its zero fields taken from rc.
+func (c *Currency) fill(rc *Currency) *Currency {
+	f := *c
+	f.Code = rc.Code
+	if f.NumericCode == "" {
+		f.NumericCode = rc.NumericCode
+	}
+	if f.Grapheme == "" {
+		f.Grapheme = rc.Grapheme
+	}
+	if f.Template == "" {
+		f.Template = rc.Template
+	}
+	if f.Decimal == "" {
+		f.Decimal = rc.Decimal
+	}
+	if f.Thousand == "" {
+		f.Thousand = rc.Thousand
+	}
+	if f.Fraction == 0 {
+		f.Fraction = rc.Fraction
 	}
 
-	return c.getDefault()
+	return &f
 }
 
 func (c *Currency) equals(oc *Currency) bool {
-	return c.Code == oc.Code
+	if c == nil || oc == nil {
+		return false
+	}
+
+	// Codes are canonical once Money is created, so only compare canonical codes when
+	// they differ, e.g. for Currency built by hand.
+	return c.Code == oc.Code || canonicalCode(c.Code) == canonicalCode(oc.Code)
 }
@@ -1,7 +1,10 @@
 package money
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -54,6 +57,29 @@ func TestCurrency_Equals(t *testing.T) {
 	}
 }
 
+func TestCurrency_CaseInsensitive(t *testing.T) {
+	AddCurrency("silver", "Ag", "1 $", ".", ",", 3)
+
+	for _, code := range []string{"SILVER", "silver", " Silver "} {
+		if c := GetCurrency(code); c == nil || c.Code != "SILVER" {
+			t.Errorf("Expected %s for %q got %+v", "SILVER", code, c)
+		}
+	}
+
+	m := &Money{Amount: 1500, Currency: &Currency{Code: "silver"}}
+	if r := m.Display(); r != "1.500 Ag" {
+		t.Errorf("Expected %s got %s", "1.500 Ag", r)
+	}
+
+	if ok, err := m.Equals(New(1500, "SILVER")); !ok || err != nil {
+		t.Errorf("Expected %v to equal %v (%v)", m, New(1500, "SILVER"), err)
+	}
+
+	if r := New(1, "xyz").Currency; r.Code != "XYZ" || r.Grapheme != "XYZ" {
+		t.Errorf("Expected fallback currency %s got %+v", "XYZ", r)
+	}
+}
+
 func TestCurrency_AddCurrency(t *testing.T) {
 	tcs := []struct {
 		code     string
@@ -72,6 +98,20 @@ func TestCurrency_AddCurrency(t *testing.T) {
 	}
 }
 
+func TestCurrency_AddCurrencyRegistered(t *testing.T) {
+	c := AddCurrency("SILVER", "Ag", "1 $", ".", ",", 2)
+	AddCurrency("BRONZE", "Cu", "1 $", ".", ",", 2)
+
+	if *GetCurrency("SILVER") != *c {
+		t.Errorf("Expected added Currency to stay registered across other updates got %+v", GetCurrency("SILVER"))
+	}
+
+	o := AddCurrency("silver", "oz", "1 $", ".", ",", 3)
+	if *GetCurrency("SILVER") != *o || c.Grapheme != "Ag" {
+		t.Errorf("Expected adding the code again to replace %+v by %+v", c, o)
+	}
+}
+
 func TestCurrency_GetCurrency(t *testing.T) {
 	code := "KLINGONDOLLAR"
 	desired := Currency{Decimal: ".", Thousand: ",", Code: code, Fraction: 2, Grapheme: "$", Template: "$1"}
@@ -137,3 +177,158 @@ func TestCurrencies(t *testing.T) {
 		t.Errorf("unexpected Currency returned. expected: %v, got %v", curBar, ac)
 	}
 }
+
+func TestCurrency_AddCurrencyConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			AddCurrency(fmt.Sprintf("CC%d", i), "c", "1 $", ".", ",", 2)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = New(100, EUR).Display()
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < 8; i++ {
+		if GetCurrency(fmt.Sprintf("CC%d", i)) == nil {
+			t.Errorf("Expected currency %s to be registered", fmt.Sprintf("CC%d", i))
+		}
+	}
+}
+
+func BenchmarkGetCurrency(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = GetCurrency(EUR)
+		}
+	})
+}
+
+func TestCurrency_GetSnapshot(t *testing.T) {
+	old := GetCurrency(EUR)
+	defer updateRegistry(func(cs Currencies) Currencies { return cs.Add(old) })
+
+	before := New(123456, EUR)
+	bare := &Money{Amount: 123456, Currency: &Currency{Code: EUR}}
+
+	AddCurrency(EUR, "EUR", "1 $", ",", ".", 2)
+
+	if r, expected := before.Display(), "€1,234.56"; r != expected {
+		t.Errorf("Expected Money created before the update to display %s got %s", expected, r)
+	}
+
+	if r, expected := bare.Display(), "1.234,56 EUR"; r != expected {
+		t.Errorf("Expected bare Currency to resolve to the update and display %s got %s", expected, r)
+	}
+
+	if f := (&Currency{Code: EUR}).Formatter(); f.Template != "1 $" {
+		t.Errorf("Expected Formatter of bare Currency to be resolved got %+v", f)
+	}
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	defer updateRegistry(func(cs Currencies) Currencies {
+		delete(cs, "PTS")
+		return cs
+	})
+
+	if err := RegisterCurrency(Currency{Code: "pts", Grapheme: "pts", Thousand: ","}); err != nil {
+		t.Fatal(err)
+	}
+
+	c := GetCurrency("PTS")
+	if c == nil || c.Code != "PTS" || c.Fraction != 0 || c.Decimal != "." || c.Template != "1 $" {
+		t.Fatalf("Expected registered currency with defaults got %+v", c)
+	}
+
+	if r, expected := New(123456, "PTS").Display(), "123,456 pts"; r != expected {
+		t.Errorf("Expected %s got %s", expected, r)
+	}
+
+	if err := RegisterCurrency(Currency{Code: "PTS"}); !errors.Is(err, ErrCurrencyExists) {
+		t.Errorf("Expected %v got %v", ErrCurrencyExists, err)
+	}
+
+	if err := RegisterCurrency(Currency{Code: EUR, Fraction: 2}); !errors.Is(err, ErrCurrencyExists) {
+		t.Errorf("Expected %v got %v", ErrCurrencyExists, err)
+	}
+
+	tcs := []Currency{
+		{},
+		{Code: "A:B"},
+		{Code: "A|B"},
+		{Code: "A B"},
+		{Code: "NEG", Fraction: -1},
+		{Code: "BIG", Fraction: 19},
+		{Code: "TPL", Template: "$"},
+		{Code: "SEP", Decimal: ",", Thousand: ","},
+	}
+
+	for _, tc := range tcs {
+		if err := RegisterCurrency(tc); !errors.Is(err, ErrInvalidCurrency) {
+			t.Errorf("Expected %v for %+v got %v", ErrInvalidCurrency, tc, err)
+		}
+	}
+}
+
+func TestOverrideCurrency(t *testing.T) {
+	old := GetCurrency(JPY)
+	defer updateRegistry(func(cs Currencies) Currencies { return cs.Add(old) })
+
+	before := New(1234, JPY)
+
+	c := *old
+	c.Code, c.Grapheme, c.Template = "", "円", "1$"
+	if err := OverrideCurrency("jpy", c); err != nil {
+		t.Fatal(err)
+	}
+
+	if r, expected := New(1234, JPY).Display(), "1,234円"; r != expected {
+		t.Errorf("Expected %s got %s", expected, r)
+	}
+
+	if r, expected := before.Display(), "¥1,234"; r != expected {
+		t.Errorf("Expected Money created before to display %s got %s", expected, r)
+	}
+
+	if err := OverrideCurrency(JPY, Currency{Code: USD}); !errors.Is(err, ErrInvalidCurrency) {
+		t.Errorf("Expected %v got %v", ErrInvalidCurrency, err)
+	}
+
+	if err := OverrideCurrency("GEMS", Currency{Fraction: 1, Grapheme: "💎"}); err != nil || GetCurrency("GEMS") == nil {
+		t.Errorf("Expected GEMS to be registered got %v", err)
+	}
+	updateRegistry(func(cs Currencies) Currencies {
+		delete(cs, "GEMS")
+		return cs
+	})
+}
+
+func TestCurrency_GetPartial(t *testing.T) {
+	tcs := []struct {
+		currency *Currency
+		expected string
+	}{
+		{&Currency{Code: USD}, "$12.34"},
+		{&Currency{Code: USD, Fraction: 2}, "$12.34"},
+		{&Currency{Code: "usd", NumericCode: "840"}, "$12.34"},
+		{&Currency{Code: EUR, Grapheme: "EUR"}, "EUR12.34"},
+		{&Currency{Code: "XYZ", Fraction: 2}, "12.34XYZ"},
+	}
+
+	for _, tc := range tcs {
+		m := &Money{Amount: 1234, Currency: tc.currency}
+		if r := m.Display(); r != tc.expected {
+			t.Errorf("Expected %+v to display %s got %s", tc.currency, tc.expected, r)
+		}
+	}
+
+	c := &Currency{Code: USD, Fraction: 3, Decimal: ","}
+	if g := c.get(); g.Fraction != 3 || g.Decimal != "," || g.Grapheme != "$" || c.Grapheme != "" {
+		t.Errorf("Expected fields of %+v to be kept and the rest filled got %+v", c, g)
+	}
+}
@@ -0,0 +1,116 @@
+package money
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Versions of the currency datasets embedded in the package.
+const (
+	// DatasetLegacy is the dataset the package has always shipped, including withdrawn
+	// currencies like EEK and non-ISO codes like GGP. It is active by default.
+	DatasetLegacy = "legacy"
+
+	// DatasetISO4217 holds the currencies of ISO 4217 list one in force on 2025-01-01. Like
+	// DatasetLegacy it has no fund codes, bond market units, XPD, XPT, XSU, XUA, XTS and XXX.
+	DatasetISO4217 = "iso4217-2025-01-01"
+)
+
+var (
+	datasets = map[string]Currencies{
+		DatasetLegacy:  cloneCurrencies(currencies, nil),
+		DatasetISO4217: iso4217(),
+	}
+
+	activeDataset = DatasetLegacy
+)
+
+// RegisterDataset registers a currency dataset under given version, so it can be selected
+// with UseDataset, e.g. to pin the exact table an audit relies on. The dataset is copied.
+func RegisterDataset(version string, cs Currencies) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	datasets[version] = cloneCurrencies(cs, nil)
+}
+
+// UseDataset makes the currency dataset of given version the active one, used for all
+// currency lookups. Currencies added with AddCurrency, RegisterCurrency or
+// OverrideCurrency before aren't kept.
+// It returns ErrUnknownDataset for versions which aren't registered.
+func UseDataset(version string) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	cs, ok := datasets[version]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownDataset, version)
+	}
+
+	cs = cloneCurrencies(cs, nil)
+	registry.Store(&cs)
+	activeDataset = version
+
+	return nil
+}
+
+// DatasetVersion returns the version of the active currency dataset.
+func DatasetVersion() string {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	return activeDataset
+}
+
+// Datasets returns the sorted versions of all registered currency datasets.
+func Datasets() []string {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	vs := make([]string, 0, len(datasets))
+	for v := range datasets {
+		vs = append(vs, v)
+	}
+	slices.Sort(vs)
+
+	return vs
+}
+
+// iso4217Withdrawn holds the codes of DatasetLegacy with a numeric code which were
+// withdrawn from ISO 4217 before the date of DatasetISO4217.
+var iso4217Withdrawn = []string{BYR, CUC, HRK, LTL, LVL, SKK, SLL, TRL, VEF, ZWD, ZWL}
+
+// iso4217Introduced holds the currencies of ISO 4217 missing from DatasetLegacy.
+var iso4217Introduced = Currencies{
+	"MRU": {Decimal: ".", Thousand: ",", Code: "MRU", Fraction: 2, NumericCode: "929", Grapheme: "UM", Template: "1 $"},
+	"SLE": {Decimal: ".", Thousand: ",", Code: "SLE", Fraction: 2, NumericCode: "925", Grapheme: "Le", Template: "1 $"},
+	"STN": {Decimal: ".", Thousand: ",", Code: "STN", Fraction: 2, NumericCode: "930", Grapheme: "Db", Template: "1 $"},
+	"VED": {Decimal: ".", Thousand: ",", Code: "VED", Fraction: 2, NumericCode: "926", Grapheme: "Bs.D", Template: "$1"},
+	"ZWG": {Decimal: ".", Thousand: ",", Code: "ZWG", Fraction: 2, NumericCode: "924", Grapheme: "ZiG", Template: "1 $"},
+}
+
+// iso4217 returns the currencies of DatasetISO4217.
+func iso4217() Currencies {
+	cs := cloneCurrencies(currencies, func(c *Currency) bool {
+		return c.NumericCode != "" && !slices.Contains(iso4217Withdrawn, c.Code)
+	})
+	for code, c := range cloneCurrencies(iso4217Introduced, nil) {
+		cs[code] = c
+	}
+
+	return cs
+}
+
+// cloneCurrencies returns a deep copy of the currencies accepted by keep, or of all when keep is nil.
+func cloneCurrencies(cs Currencies, keep func(c *Currency) bool) Currencies {
+	r := make(Currencies, len(cs))
+	for code, c := range cs {
+		if keep == nil || keep(c) {
+			cc := *c
+			cc.Code = canonicalCode(cc.Code)
+			r[canonicalCode(code)] = &cc
+		}
+	}
+
+	return r
+}
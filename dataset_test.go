@@ -0,0 +1,48 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUseDataset(t *testing.T) {
+	defer func() { _ = UseDataset(DatasetLegacy) }()
+
+	if DatasetVersion() != DatasetLegacy || GetCurrency("EEK") == nil {
+		t.Errorf("Expected %s dataset to be active got %s", DatasetLegacy, DatasetVersion())
+	}
+
+	if err := UseDataset(DatasetISO4217); err != nil {
+		t.Fatal(err)
+	}
+
+	if DatasetVersion() != DatasetISO4217 || GetCurrency("EEK") != nil || GetCurrency(EUR) == nil {
+		t.Errorf("Expected %s dataset to be active got %s", DatasetISO4217, DatasetVersion())
+	}
+
+	for _, code := range []string{HRK, VEF, ZWD, CUC, SLL} {
+		if GetCurrency(code) != nil {
+			t.Errorf("Expected withdrawn %s not to be in %s", code, DatasetISO4217)
+		}
+	}
+
+	if c := GetCurrency("SLE"); c == nil || c.NumericCode != "925" || GetCurrency("STN") == nil {
+		t.Errorf("Expected introduced %s in %s got %+v", "SLE", DatasetISO4217, c)
+	}
+
+	RegisterDataset("pinned", Currencies{"PTS": {Code: "PTS", Fraction: 0, Template: "1 $", Grapheme: "pts"}})
+	defer delete(datasets, "pinned")
+
+	if err := UseDataset("pinned"); err != nil || GetCurrency("PTS") == nil || GetCurrency(EUR) != nil {
+		t.Errorf("Expected pinned dataset to be active got %s (%v)", DatasetVersion(), err)
+	}
+
+	if expected := []string{DatasetISO4217, DatasetLegacy, "pinned"}; !reflect.DeepEqual(Datasets(), expected) {
+		t.Errorf("Expected %v got %v", expected, Datasets())
+	}
+
+	if err := UseDataset("missing"); !errors.Is(err, ErrUnknownDataset) || DatasetVersion() != "pinned" {
+		t.Errorf("Expected %v got %v", ErrUnknownDataset, err)
+	}
+}
@@ -0,0 +1,132 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"strings"
+)
+
+// pow10 returns 10 raised to e for exponents representable as int64.
+func pow10(e int) (int64, bool) {
+	if e < 0 || e > 18 {
+		return 0, false
+	}
+
+	p := int64(1)
+	for i := 0; i < e; i++ {
+		p *= 10
+	}
+
+	return p, true
+}
+
+// rescaleAmount converts amount expressed with from fraction digits into to fraction digits.
+// It fails when non-zero digits would be dropped or when the result doesn't fit into int64.
+func rescaleAmount(a Amount, from, to int) (Amount, error) {
+	switch {
+	case from == to:
+		return a, nil
+	case to > from:
+		p, ok := pow10(to - from)
+		if !ok || (a != 0 && (a > MaxAmount/Amount(p) || a < MinAmount/Amount(p))) {
+			return 0, ErrOverflow
+		}
+
+		return a * Amount(p), nil
+	}
+
+	p, ok := pow10(from - to)
+	if !ok {
+		if a == 0 {
+			return 0, nil
+		}
+
+		return 0, ErrPrecisionLoss
+	}
+
+	if a%Amount(p) != 0 {
+		return 0, ErrPrecisionLoss
+	}
+
+	return a / Amount(p), nil
+}
+
+// formatDecimal renders amount as a plain decimal string like "-1234.56",
+// without grouping or currency symbols.
+func formatDecimal(a Amount, fraction int) string {
+	return NewFormatter(fraction, ".", "", "", "1").Format(int64(a))
+}
+
+// parseDecimal parses a plain decimal string like "-1234.56" into an amount
+// with the given number of fraction digits. Extra fraction digits are accepted
+// only when they are zeros. Failures are reported as ParseError.
+func parseDecimal(s string, fraction int) (Amount, error) {
+	in := s
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	ip, fp, hasPoint := strings.Cut(s, ".")
+	if ip == "" || (hasPoint && fp == "") || !isDigits(ip) || !isDigits(fp) {
+		return 0, &ParseError{Input: in, Err: ErrInvalidAmount}
+	}
+
+	if len(fp) > fraction {
+		if strings.Trim(fp[fraction:], "0") != "" {
+			return 0, &ParseError{Input: in, Err: ErrPrecisionLoss}
+		}
+		fp = fp[:fraction]
+	}
+	fp += strings.Repeat("0", fraction-len(fp))
+
+	// Accumulate the magnitude, negative amounts reach one further than positive ones.
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++
+	}
+
+	var a uint64
+	for _, r := range ip + fp {
+		d := uint64(r - '0')
+		if a > (limit-d)/10 {
+			return 0, &ParseError{Input: in, Err: ErrOverflow}
+		}
+		a = a*10 + d
+	}
+
+	if neg {
+		return Amount(-a), nil
+	}
+
+	return Amount(a), nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// roundAmount converts amount expressed with from fraction digits into to fraction digits,
+// rounding dropped digits with given rounding mode.
+func roundAmount(a Amount, from, to int, mode RoundingMode) (Amount, error) {
+	if to >= from {
+		return rescaleAmount(a, from, to)
+	}
+
+	r := roundQuo(big.NewInt(int64(a)), scale(from-to), mode)
+	if !r.IsInt64() {
+		return 0, ErrOverflow
+	}
+
+	return Amount(r.Int64()), nil
+}
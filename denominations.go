@@ -0,0 +1,120 @@
+package money
+
+import (
+	"maps"
+	"math/big"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Denominations are the banknotes and coins of a currency in circulation, in minor units
+// ordered from the highest down.
+type Denominations struct {
+	Banknotes []Amount
+	Coins     []Amount
+}
+
+// All returns the distinct banknotes and coins ordered from the highest down.
+func (d Denominations) All() []Amount {
+	all := slices.Concat(d.Banknotes, d.Coins)
+	slices.SortFunc(all, func(a, b Amount) int { return b.Cmp(a) })
+
+	return slices.Compact(all)
+}
+
+// Smallest returns the smallest denomination, zero when there's none.
+func (d Denominations) Smallest() Amount {
+	all := d.All()
+	if len(all) == 0 {
+		return 0
+	}
+
+	return all[len(all)-1]
+}
+
+// The denomination table is swapped atomically like the currency registry.
+var (
+	denominationsMu sync.Mutex
+	denominations   atomic.Pointer[map[string]Denominations]
+)
+
+func init() {
+	ds := map[string]Denominations{
+		AUD: {Banknotes: []Amount{10000, 5000, 2000, 1000, 500}, Coins: []Amount{200, 100, 50, 20, 10, 5}},
+		CAD: {Banknotes: []Amount{10000, 5000, 2000, 1000, 500}, Coins: []Amount{200, 100, 25, 10, 5}},
+		CHF: {Banknotes: []Amount{100000, 20000, 10000, 5000, 2000, 1000}, Coins: []Amount{500, 200, 100, 50, 20, 10, 5}},
+		CNY: {Banknotes: []Amount{10000, 5000, 2000, 1000, 500, 100}, Coins: []Amount{100, 50, 10}},
+		DKK: {Banknotes: []Amount{100000, 50000, 20000, 10000, 5000}, Coins: []Amount{2000, 1000, 500, 200, 100, 50}},
+		EUR: {Banknotes: []Amount{50000, 20000, 10000, 5000, 2000, 1000, 500}, Coins: []Amount{200, 100, 50, 20, 10, 5, 2, 1}},
+		GBP: {Banknotes: []Amount{5000, 2000, 1000, 500}, Coins: []Amount{200, 100, 50, 20, 10, 5, 2, 1}},
+		INR: {Banknotes: []Amount{50000, 20000, 10000, 5000, 2000, 1000}, Coins: []Amount{2000, 1000, 500, 200, 100}},
+		JPY: {Banknotes: []Amount{10000, 5000, 2000, 1000}, Coins: []Amount{500, 100, 50, 10, 5, 1}},
+		NOK: {Banknotes: []Amount{100000, 50000, 20000, 10000}, Coins: []Amount{2000, 1000, 500, 100}},
+		NZD: {Banknotes: []Amount{10000, 5000, 2000, 1000, 500}, Coins: []Amount{200, 100, 50, 20, 10}},
+		SEK: {Banknotes: []Amount{100000, 50000, 20000, 10000, 5000, 2000}, Coins: []Amount{1000, 500, 200, 100}},
+		USD: {Banknotes: []Amount{10000, 5000, 2000, 1000, 500, 200, 100}, Coins: []Amount{100, 50, 25, 10, 5, 1}},
+	}
+	denominations.Store(&ds)
+}
+
+// GetDenominations returns the denominations of given currency and whether there are any.
+func GetDenominations(code string) (Denominations, bool) {
+	d, ok := (*denominations.Load())[canonicalCode(code)]
+	return Denominations{Banknotes: slices.Clone(d.Banknotes), Coins: slices.Clone(d.Coins)}, ok
+}
+
+// RegisterDenominations sets the denominations of given currency, e.g. when a currency
+// introduces or withdraws a banknote. Denominations are copied and sorted from the highest
+// down, non-positive ones are dropped. No denominations remove the ones of the currency.
+func RegisterDenominations(code string, d Denominations) {
+	clean := func(as []Amount) []Amount {
+		as = slices.DeleteFunc(slices.Clone(as), func(a Amount) bool { return a <= 0 })
+		slices.SortFunc(as, func(a, b Amount) int { return b.Cmp(a) })
+		return slices.Compact(as)
+	}
+
+	denominationsMu.Lock()
+	defer denominationsMu.Unlock()
+
+	ds := maps.Clone(*denominations.Load())
+	d = Denominations{Banknotes: clean(d.Banknotes), Coins: clean(d.Coins)}
+	if len(d.Banknotes) == 0 && len(d.Coins) == 0 {
+		delete(ds, canonicalCode(code))
+	} else {
+		ds[canonicalCode(code)] = d
+	}
+	denominations.Store(&ds)
+}
+
+// IsCashPayable reports whether Money can be paid exactly in the banknotes and coins of
+// its currency, that is whether it's a multiple of the smallest denomination, e.g.
+// CHF 0.05 but not CHF 0.01. It reports false for currencies without denominations and
+// for Money without Currency.
+func (m *Money) IsCashPayable() bool {
+	if !m.IsValid() {
+		return false
+	}
+
+	d, ok := GetDenominations(m.Currency.Code)
+	if !ok {
+		return false
+	}
+
+	// The denominations are given in minor units of the registered Currency, Money may
+	// hold other fraction digits, e.g. after Rescale.
+	rc := GetCurrency(m.Currency.Code)
+	f := m.Currency.get().Fraction
+	if rc == nil || rc.Fraction == f {
+		return m.Amount%d.Smallest() == 0
+	}
+
+	a, s := big.NewInt(int64(m.Amount)), big.NewInt(int64(d.Smallest()))
+	if f > rc.Fraction {
+		s.Mul(s, scale(f-rc.Fraction))
+	} else {
+		a.Mul(a, scale(rc.Fraction-f))
+	}
+
+	return new(big.Int).Rem(a, s).Sign() == 0
+}
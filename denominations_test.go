@@ -0,0 +1,86 @@
+package money
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGetDenominations(t *testing.T) {
+	d, ok := GetDenominations("usd")
+	if !ok || d.Smallest() != 1 {
+		t.Fatalf("Expected USD denominations got %v (%v)", d, ok)
+	}
+
+	all := d.All()
+	if len(all) != 12 || all[0] != 10000 || slices.Index(all, 100) != 6 || all[7] != 50 {
+		t.Errorf("Expected distinct denominations highest first got %v", all)
+	}
+
+	d.Coins[0] = 0
+	if d2, _ := GetDenominations(USD); d2.Coins[0] != 100 {
+		t.Errorf("Expected a copy of the denominations got %v", d2.Coins)
+	}
+
+	if _, ok := GetDenominations("XTS"); ok {
+		t.Errorf("Expected no denominations for %s", "XTS")
+	}
+}
+
+func TestRegisterDenominations(t *testing.T) {
+	defer RegisterDenominations("XTS", Denominations{})
+
+	RegisterDenominations("xts", Denominations{Banknotes: []Amount{100, 500, 100, -1}, Coins: []Amount{10, 50}})
+
+	d, ok := GetDenominations("XTS")
+	if !ok || !slices.Equal(d.Banknotes, []Amount{500, 100}) || !slices.Equal(d.Coins, []Amount{50, 10}) {
+		t.Errorf("Expected sorted distinct denominations got %v", d)
+	}
+
+	RegisterDenominations("XTS", Denominations{})
+	if _, ok := GetDenominations("XTS"); ok {
+		t.Errorf("Expected denominations of %s to be removed", "XTS")
+	}
+}
+
+func TestMoney_IsCashPayable(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		expected bool
+	}{
+		{New(1234, EUR), true},
+		{New(1235, CHF), true},
+		{New(1234, CHF), false},
+		{New(-1235, CHF), true},
+		{New(1210, NZD), true},
+		{New(1215, NZD), false},
+		{New(1200, SEK), true},
+		{New(1250, SEK), false},
+		{New(1234, "XTS"), false},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.money.IsCashPayable(); r != tc.expected {
+			t.Errorf("Expected %v for %s got %v", tc.expected, tc.money.Display(), r)
+		}
+	}
+
+	rescaled := []struct {
+		money    *Money
+		expected bool
+	}{
+		{New(12350, CHF, WithFraction(3)), true},
+		{New(12345, CHF, WithFraction(3)), false},
+		{New(12, CHF, WithFraction(0)), true},
+		{New(3, CHF, WithFraction(1)), true},
+	}
+
+	for _, tc := range rescaled {
+		if r := tc.money.IsCashPayable(); r != tc.expected {
+			t.Errorf("Expected %v for %s got %v", tc.expected, tc.money.Display(), r)
+		}
+	}
+
+	if (&Money{Amount: 5}).IsCashPayable() || (&Money{Currency: &Currency{}}).IsCashPayable() {
+		t.Error("Expected Money without Currency not to be payable")
+	}
+}
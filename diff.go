@@ -0,0 +1,45 @@
+package money
+
+// Mismatch describes Money that differs between two slices at the same index.
+// A or B is nil when the index is past the end of the corresponding slice.
+type Mismatch struct {
+	Index int
+	A, B  *Money
+}
+
+// EqualSlices reports whether two slices have the same length and Equal Money at every index.
+func EqualSlices(a, b []*Money) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DiffSlices returns the mismatches between two slices in index order, comparing Money
+// with Equal. Indexes present in only one of the slices are reported as well.
+// It returns nil when EqualSlices reports true.
+func DiffSlices(a, b []*Money) []Mismatch {
+	var ds []Mismatch
+	for i := 0; i < max(len(a), len(b)); i++ {
+		var ma, mb *Money
+		if i < len(a) {
+			ma = a[i]
+		}
+		if i < len(b) {
+			mb = b[i]
+		}
+
+		if i >= len(a) || i >= len(b) || !ma.Equal(mb) {
+			ds = append(ds, Mismatch{Index: i, A: ma, B: mb})
+		}
+	}
+
+	return ds
+}
@@ -0,0 +1,47 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestEqualSlices(t *testing.T) {
+	tcs := []struct {
+		a, b     []*Money
+		expected bool
+	}{
+		{moneys(EUR, 1, 2), moneys(EUR, 1, 2), true},
+		{nil, []*Money{}, true},
+		{[]*Money{nil}, []*Money{nil}, true},
+		{moneys(EUR, 1, 2), moneys(EUR, 1), false},
+		{moneys(EUR, 1, 2), moneys(EUR, 1, 3), false},
+		{moneys(EUR, 1), moneys(USD, 1), false},
+	}
+
+	for _, tc := range tcs {
+		if r := EqualSlices(tc.a, tc.b); r != tc.expected {
+			t.Errorf("Expected %v for %v and %v got %v", tc.expected, amounts(tc.a), amounts(tc.b), r)
+		}
+	}
+}
+
+func TestDiffSlices(t *testing.T) {
+	a := moneys(EUR, 1, 2, 3)
+	b := []*Money{New(1, EUR), New(2, USD), New(3, EUR), New(4, EUR)}
+
+	ds := DiffSlices(a, b)
+	if len(ds) != 2 {
+		t.Fatalf("Expected %d mismatches got %d", 2, len(ds))
+	}
+
+	if ds[0].Index != 1 || ds[0].A != a[1] || ds[0].B != b[1] {
+		t.Errorf("Unexpected mismatch %+v", ds[0])
+	}
+
+	if ds[1].Index != 3 || ds[1].A != nil || ds[1].B != b[3] {
+		t.Errorf("Unexpected mismatch %+v", ds[1])
+	}
+
+	if ds := DiffSlices(a, moneys(EUR, 1, 2, 3)); ds != nil {
+		t.Errorf("Expected no mismatches got %+v", ds)
+	}
+}
@@ -0,0 +1,160 @@
+// Package dunning computes late fees of overdue balances of money.Money. Rules combine a
+// flat fee, a percentage charged per period, grace days and a cap, and produce the fees
+// as dated entries.
+//
+// A balance due on a day becomes late on the day after the grace days following it.
+// The flat fee and the first periodic fee are charged on that day, further periodic fees
+// every PeriodDays days after. Days are calendar days in the location of the due date.
+package dunning
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var (
+	// ErrInvalidRules happens when Rules have negative days or rates, or a Rate without PeriodDays.
+	ErrInvalidRules = errors.New("dunning: invalid rules")
+)
+
+// Rules configure the late fees charged on overdue balances.
+type Rules struct {
+	// GraceDays is the number of days after the due date without fees.
+	GraceDays int
+	// Flat is charged once when the balance becomes late. Nil means none.
+	Flat *money.Money
+	// Rate is the fraction of the overdue balance charged every period, e.g. 3/200 for
+	// 1.5% a period. Nil means none.
+	Rate *big.Rat
+	// PeriodDays is the length of a period in days.
+	PeriodDays int
+	// Compound charges Rate on the balance including the fees charged before.
+	Compound bool
+	// Cap is the highest sum of all fees. Nil means uncapped.
+	Cap *money.Money
+	// Rounding is used by percentage fees.
+	Rounding money.RoundingMode
+}
+
+// Entry is a late fee charged on a day.
+type Entry struct {
+	Date        time.Time
+	Description string
+	Fee         *money.Money
+	// Fees is the sum of all fees up to and including this one.
+	Fees *money.Money
+	// Balance is the overdue balance plus Fees.
+	Balance *money.Money
+}
+
+// Schedule returns the late fees of the balance due on given day charged up to asOf,
+// inclusive, in the order they are charged. Fees stop once they reach the Cap; the fee
+// reaching it is reduced to it. It returns ErrInvalidRules, money.ErrNilMoney,
+// money.ErrInvalidAmount for a negative balance or fees, money.ErrCurrencyMismatch for
+// fees in other currencies and money.ErrOverflow.
+func (r Rules) Schedule(balance *money.Money, due, asOf time.Time) ([]Entry, error) {
+	if err := r.validate(balance); err != nil {
+		return nil, err
+	}
+
+	loc := due.Location()
+	y, m, d := due.Date()
+	day := func(n int) time.Time { return time.Date(y, m, d+n, 0, 0, 0, 0, loc) }
+
+	fees := &money.Money{Currency: balance.Currency}
+	var es []Entry
+
+	charge := func(date time.Time, desc string, fee *money.Money) (bool, error) {
+		capped := false
+		if r.Cap != nil {
+			left, _ := r.Cap.Subtract(fees)
+			if fee.Amount >= left.Amount {
+				fee, capped = left, true
+			}
+		}
+
+		var err error
+		if fees, err = fees.Add(fee); err != nil {
+			return false, err
+		}
+
+		total, err := balance.Add(fees)
+		if err != nil {
+			return false, err
+		}
+
+		if !fee.IsZero() {
+			es = append(es, Entry{Date: date, Description: desc, Fee: fee, Fees: fees, Balance: total})
+		}
+
+		return capped, nil
+	}
+
+	late := r.GraceDays + 1
+	for n := 0; ; n++ {
+		date := day(late + n*r.PeriodDays)
+		if date.After(asOf) {
+			break
+		}
+
+		if n == 0 && r.Flat != nil {
+			if capped, err := charge(date, "Late fee", r.Flat); err != nil || capped {
+				return es, err
+			}
+		}
+
+		if r.Rate == nil {
+			break
+		}
+
+		base := balance
+		if r.Compound {
+			base, _ = balance.Add(fees)
+		}
+
+		fee, err := (&money.Money{Currency: balance.Currency}).SetRat(new(big.Rat).Mul(base.AsRat(), r.Rate), r.Rounding)
+		if err != nil {
+			return es, err
+		}
+
+		if capped, err := charge(date, fmt.Sprintf("Late charge for period %d", n+1), fee); err != nil || capped {
+			return es, err
+		}
+	}
+
+	return es, nil
+}
+
+func (r Rules) validate(balance *money.Money) error {
+	if !balance.IsValid() {
+		return money.ErrNilMoney
+	}
+
+	if balance.IsNegative() {
+		return money.ErrInvalidAmount
+	}
+
+	if r.GraceDays < 0 || (r.Rate != nil && (r.Rate.Sign() < 0 || r.PeriodDays < 1)) {
+		return ErrInvalidRules
+	}
+
+	for _, m := range []*money.Money{r.Flat, r.Cap} {
+		if m == nil {
+			continue
+		}
+
+		if _, err := balance.Compare(m); err != nil {
+			return err
+		}
+
+		if m.IsNegative() {
+			return money.ErrInvalidAmount
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package dunning
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func day(m time.Month, d int) time.Time {
+	return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func eur(amount int64) *money.Money {
+	return money.New(amount, money.EUR)
+}
+
+func TestRules_Schedule(t *testing.T) {
+	due := day(time.January, 31)
+
+	type entry struct {
+		date time.Time
+		fee  money.Amount
+	}
+
+	tcs := []struct {
+		rules    Rules
+		asOf     time.Time
+		expected []entry
+	}{
+		{
+			Rules{GraceDays: 10, Flat: eur(2500), Rate: big.NewRat(1, 100), PeriodDays: 30},
+			day(time.May, 1),
+			[]entry{{day(time.February, 11), 2500}, {day(time.February, 11), 1000}, {day(time.March, 13), 1000}, {day(time.April, 12), 1000}},
+		},
+		{
+			Rules{Rate: big.NewRat(1, 10), PeriodDays: 30, Compound: true},
+			day(time.April, 2),
+			[]entry{{day(time.February, 1), 10000}, {day(time.March, 3), 11000}, {day(time.April, 2), 12100}},
+		},
+		{
+			Rules{Flat: eur(2500), Rate: big.NewRat(1, 100), PeriodDays: 30, Cap: eur(4000)},
+			day(time.December, 31),
+			[]entry{{day(time.February, 1), 2500}, {day(time.February, 1), 1000}, {day(time.March, 3), 500}},
+		},
+		{
+			Rules{GraceDays: 10, Flat: eur(2500)},
+			day(time.December, 31),
+			[]entry{{day(time.February, 11), 2500}},
+		},
+		{
+			Rules{GraceDays: 10, Flat: eur(2500)},
+			day(time.February, 10),
+			nil,
+		},
+	}
+
+	for i, tc := range tcs {
+		es, err := tc.rules.Schedule(eur(100000), due, tc.asOf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(es) != len(tc.expected) {
+			t.Errorf("Expected %d entries in schedule %d got %d", len(tc.expected), i, len(es))
+			continue
+		}
+
+		var fees money.Amount
+		for j, e := range tc.expected {
+			fees += e.fee
+			got := es[j]
+			if !got.Date.Equal(e.date) || got.Fee.Amount != e.fee || got.Fees.Amount != fees || got.Balance.Amount != 100000+fees {
+				t.Errorf("Expected fee %d on %s in schedule %d got %v on %s", e.fee, e.date.Format(time.DateOnly), i, got.Fee, got.Date.Format(time.DateOnly))
+			}
+		}
+	}
+}
+
+func TestRules_ScheduleErrors(t *testing.T) {
+	tcs := []struct {
+		rules   Rules
+		balance *money.Money
+		err     error
+	}{
+		{Rules{}, nil, money.ErrNilMoney},
+		{Rules{}, eur(-1), money.ErrInvalidAmount},
+		{Rules{GraceDays: -1}, eur(1), ErrInvalidRules},
+		{Rules{Rate: big.NewRat(1, 100)}, eur(1), ErrInvalidRules},
+		{Rules{Flat: money.New(1, money.USD)}, eur(1), money.ErrCurrencyMismatch},
+		{Rules{Cap: eur(-1)}, eur(1), money.ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		if _, err := tc.rules.Schedule(tc.balance, day(time.January, 1), day(time.March, 1)); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v got %v", tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package money
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// EncodingVersion is a version of the canonical binary encoding of Money.
+type EncodingVersion byte
+
+// Versions of the canonical encoding. Every version starts with its version byte,
+// followed by the length of the canonical currency code and the code:
+//
+//	V1: version, code length, code, amount as 8 bytes big-endian
+//	V2: version, code length, code, fraction digits, amount as 8 bytes big-endian
+//
+// V2 records the fraction digits the amount is held in, so events keep their value when
+// the fraction of a currency changes in the registry.
+const (
+	EncodingV1 EncodingVersion = 1
+	EncodingV2 EncodingVersion = 2
+
+	// EncodingCurrent is the version written by MarshalCanonical.
+	EncodingCurrent = EncodingV2
+)
+
+// MarshalCanonical returns the canonical encoding of Money in EncodingCurrent. The
+// encoding is byte-stable, equal Money always encode to identical bytes, so it's fit for
+// event stores, signatures and content hashes.
+func (m *Money) MarshalCanonical() ([]byte, error) {
+	return m.AppendCanonical(nil, EncodingCurrent)
+}
+
+// AppendCanonical appends the canonical encoding of Money in given version to b.
+// It returns ErrNilMoney, ErrInvalidEncoding for unknown versions and codes longer than
+// 255 bytes.
+func (m *Money) AppendCanonical(b []byte, v EncodingVersion) ([]byte, error) {
+	if !m.IsValid() {
+		return nil, ErrNilMoney
+	}
+
+	code := canonicalCode(m.Currency.Code)
+	if len(code) > 255 {
+		return nil, fmt.Errorf("%w: code %q too long", ErrInvalidEncoding, code)
+	}
+
+	switch v {
+	case EncodingV1:
+		b = append(b, byte(v), byte(len(code)))
+		b = append(b, code...)
+	case EncodingV2:
+		b = append(b, byte(v), byte(len(code)))
+		b = append(b, code...)
+		b = append(b, byte(m.Currency.get().Fraction))
+	default:
+		return nil, fmt.Errorf("%w: unknown version %d", ErrInvalidEncoding, v)
+	}
+
+	return binary.BigEndian.AppendUint64(b, uint64(m.Amount)), nil
+}
+
+// UnmarshalCanonical decodes Money from its canonical encoding of any version and returns
+// it along with the version. Money decoded from V2 with fraction digits other than the
+// ones of the registered Currency has a copy of the Currency with the recorded fraction,
+// like Money returned by Rescale. It returns ErrInvalidEncoding for malformed data.
+func UnmarshalCanonical(data []byte) (*Money, EncodingVersion, error) {
+	if len(data) < 2 {
+		return nil, 0, fmt.Errorf("%w: %d bytes", ErrInvalidEncoding, len(data))
+	}
+
+	v, n := EncodingVersion(data[0]), int(data[1])
+	size := 2 + n + 8
+	if v == EncodingV2 {
+		size++
+	}
+
+	switch {
+	case v != EncodingV1 && v != EncodingV2:
+		return nil, 0, fmt.Errorf("%w: unknown version %d", ErrInvalidEncoding, v)
+	case len(data) != size || n == 0:
+		return nil, 0, fmt.Errorf("%w: %d bytes for version %d", ErrInvalidEncoding, len(data), v)
+	}
+
+	code := string(data[2 : 2+n])
+	if code != canonicalCode(code) {
+		return nil, 0, fmt.Errorf("%w: non-canonical code %q", ErrInvalidEncoding, code)
+	}
+
+	m, err := NewWithOptions(int64(binary.BigEndian.Uint64(data[size-8:])), code)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if v == EncodingV2 {
+		if f := int(data[2+n]); f != m.Currency.Fraction {
+			c := *m.Currency
+			c.Fraction = f
+			m.Currency = &c
+		}
+	}
+
+	return m, v, nil
+}
+
+// UpgradeCanonical re-encodes canonical Money of an older version in version to, e.g.
+// to migrate an event store. Upgrading from V1 records the fraction digits of the Currency
+// in the registry at the time of the upgrade. Data already in version to is returned as
+// is. It returns ErrInvalidEncoding for malformed data and downgrades.
+func UpgradeCanonical(data []byte, to EncodingVersion) ([]byte, error) {
+	m, v, err := UnmarshalCanonical(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case v == to:
+		return data, nil
+	case v > to:
+		return nil, fmt.Errorf("%w: can't downgrade version %d to %d", ErrInvalidEncoding, v, to)
+	}
+
+	return m.AppendCanonical(nil, to)
+}
@@ -0,0 +1,94 @@
+package money
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestMoney_MarshalCanonical(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		version  EncodingVersion
+		expected string
+	}{
+		{New(1234, USD), EncodingV1, "0103555344" + "00000000000004d2"},
+		{New(1234, USD), EncodingV2, "0203555344" + "02" + "00000000000004d2"},
+		{New(1234, "usd"), EncodingV2, "0203555344" + "02" + "00000000000004d2"},
+		{New(-1, JPY), EncodingV2, "02034a5059" + "00" + "ffffffffffffffff"},
+	}
+
+	for _, tc := range tcs {
+		b, err := tc.money.AppendCanonical(nil, tc.version)
+		if err != nil || hex.EncodeToString(b) != tc.expected {
+			t.Errorf("Expected %s got %x (%v)", tc.expected, b, err)
+			continue
+		}
+
+		m, v, err := UnmarshalCanonical(b)
+		if err != nil || v != tc.version || !m.Equal(tc.money) {
+			t.Errorf("Expected %v in version %d got %v in version %d (%v)", tc.money, tc.version, m, v, err)
+		}
+	}
+
+	if b, _ := New(1, EUR).MarshalCanonical(); b[0] != byte(EncodingCurrent) {
+		t.Errorf("Expected version %d got %d", EncodingCurrent, b[0])
+	}
+
+	if _, err := New(1, EUR).AppendCanonical(nil, 9); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected %v got %v", ErrInvalidEncoding, err)
+	}
+
+	if _, err := (*Money)(nil).MarshalCanonical(); !errors.Is(err, ErrNilMoney) {
+		t.Errorf("Expected %v got %v", ErrNilMoney, err)
+	}
+}
+
+func TestUnmarshalCanonical_Fraction(t *testing.T) {
+	r, _ := New(1234, USD).Rescale(4, RoundHalfEven)
+	b, _ := r.MarshalCanonical()
+
+	m, _, err := UnmarshalCanonical(b)
+	if err != nil || m.Amount != 123400 || m.Currency.Fraction != 4 || m.Display() != r.Display() {
+		t.Errorf("Expected %s got %v (%v)", r.Display(), m, err)
+	}
+
+	if b2, _ := m.MarshalCanonical(); !bytes.Equal(b, b2) {
+		t.Errorf("Expected stable bytes %x got %x", b, b2)
+	}
+}
+
+func TestUnmarshalCanonical_Errors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"01",
+		"0303555344" + "00000000000004d2",
+		"0103555344" + "000000000004d2",
+		"0203555344" + "00000000000004d2",
+		"0100" + "00000000000004d2",
+		"0103757364" + "00000000000004d2",
+	} {
+		b, _ := hex.DecodeString(s)
+		if _, _, err := UnmarshalCanonical(b); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("Expected %v for %s got %v", ErrInvalidEncoding, s, err)
+		}
+	}
+}
+
+func TestUpgradeCanonical(t *testing.T) {
+	v1, _ := New(1234, USD).AppendCanonical(nil, EncodingV1)
+	v2, _ := New(1234, USD).AppendCanonical(nil, EncodingV2)
+
+	if b, err := UpgradeCanonical(v1, EncodingV2); err != nil || !bytes.Equal(b, v2) {
+		t.Errorf("Expected %x got %x (%v)", v2, b, err)
+	}
+
+	if b, err := UpgradeCanonical(v2, EncodingV2); err != nil || !bytes.Equal(b, v2) {
+		t.Errorf("Expected %x got %x (%v)", v2, b, err)
+	}
+
+	if _, err := UpgradeCanonical(v2, EncodingV1); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected %v got %v", ErrInvalidEncoding, err)
+	}
+}
@@ -0,0 +1,66 @@
+package money
+
+import "fmt"
+
+// Errors returned by this package match one of the sentinel errors, like ErrCurrencyMismatch,
+// ErrOverflow or ErrInvalidRatio, with errors.Is. Where more context is available they are
+// returned as one of the error types below, which can be inspected with errors.As.
+
+// CurrencyMismatchError happens when two Money don't have the same Currency.
+// It carries both currency codes and matches ErrCurrencyMismatch with errors.Is.
+type CurrencyMismatchError struct {
+	A, B string
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("%s: %s vs %s", ErrCurrencyMismatch, e.A, e.B)
+}
+
+// Is reports whether target is ErrCurrencyMismatch.
+func (e *CurrencyMismatchError) Is(target error) bool {
+	return target == ErrCurrencyMismatch
+}
+
+// newCurrencyMismatch returns CurrencyMismatchError for given currencies.
+func newCurrencyMismatch(a, b *Currency) error {
+	return &CurrencyMismatchError{A: codeOf(a), B: codeOf(b)}
+}
+
+func codeOf(c *Currency) string {
+	if c == nil {
+		return ""
+	}
+
+	return c.Code
+}
+
+// UnsupportedCurrencyError happens when a Currency isn't supported by the target format or provider.
+// It carries the currency code and matches ErrUnsupportedCurrency with errors.Is.
+type UnsupportedCurrencyError struct {
+	Code string
+}
+
+func (e *UnsupportedCurrencyError) Error() string {
+	return fmt.Sprintf("%s: %q", ErrUnsupportedCurrency, e.Code)
+}
+
+// Is reports whether target is ErrUnsupportedCurrency.
+func (e *UnsupportedCurrencyError) Is(target error) bool {
+	return target == ErrUnsupportedCurrency
+}
+
+// ParseError happens when an amount string can't be parsed. It carries the input and
+// wraps the cause, one of ErrInvalidAmount, ErrPrecisionLoss or ErrOverflow.
+type ParseError struct {
+	Input string
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parsing amount %q: %v", e.Input, e.Err)
+}
+
+// Unwrap returns the cause of the ParseError.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,87 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrencyMismatchError(t *testing.T) {
+	_, err := New(1, EUR).Add(New(1, USD))
+
+	var merr *CurrencyMismatchError
+	if !errors.As(err, &merr) || merr.A != EUR || merr.B != USD || !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", &CurrencyMismatchError{A: EUR, B: USD}, err)
+	}
+
+	if expected := "currencies don't match: EUR vs USD"; err.Error() != expected {
+		t.Errorf("Expected %s got %s", expected, err)
+	}
+}
+
+func TestUnsupportedCurrencyError(t *testing.T) {
+	_, err := New(1, "XYZ").ToPayPal()
+
+	var uerr *UnsupportedCurrencyError
+	if !errors.As(err, &uerr) || uerr.Code != "XYZ" || !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", &UnsupportedCurrencyError{Code: "XYZ"}, err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tcs := []struct {
+		input string
+		err   error
+	}{
+		{"1.2x", ErrInvalidAmount},
+		{"1.234", ErrPrecisionLoss},
+		{"99999999999999999999", ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		_, err := ParseQIFAmount(tc.input, EUR, ".")
+
+		var perr *ParseError
+		if !errors.As(err, &perr) || perr.Input != tc.input || !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %q got %v", tc.err, tc.input, err)
+		}
+	}
+}
+
+func TestErrInvalidRatio(t *testing.T) {
+	if _, err := New(100, EUR).Split(0); !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Expected %v got %v", ErrInvalidRatio, err)
+	}
+
+	if _, err := New(100, EUR).Allocate(1, -1); !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Expected %v got %v", ErrInvalidRatio, err)
+	}
+
+	if _, err := WeightedAverage(moneys(EUR, 1), []int64{0}, RoundHalfUp); !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Expected %v got %v", ErrInvalidRatio, err)
+	}
+}
+
+func TestErrorSentinels(t *testing.T) {
+	ms := moneys(EUR, 1, 2, 3)
+	nilReduce := func(acc, m *Money) (*Money, error) { return nil, nil }
+
+	tcs := []struct {
+		name string
+		err  error
+		fn   func() error
+	}{
+		{"Percentile", ErrInvalidRatio, func() error { _, err := Percentile(ms, 101, InterpolateLinear, RoundHalfUp); return err }},
+		{"TopK", ErrInvalidRatio, func() error { _, err := TopK(ms, 0); return err }},
+		{"WeightedAverage", ErrInvalidRatio, func() error { _, err := WeightedAverage(ms, []int64{1}, RoundHalfUp); return err }},
+		{"Reduce nil init", ErrNilMoney, func() error { _, err := Reduce(ms, nil, nilReduce); return err }},
+		{"Reduce nil result", ErrNilMoney, func() error { _, err := Reduce(ms, New(0, EUR), nilReduce); return err }},
+		{"Bucketize nil bound", ErrNilMoney, func() error { _, err := Bucketize(ms, New(1, EUR), nil); return err }},
+		{"Bucketize unordered", ErrInvalidRange, func() error { _, err := Bucketize(ms, New(2, EUR), New(1, EUR)); return err }},
+	}
+
+	for _, tc := range tcs {
+		if err := tc.fn(); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %s got %v", tc.err, tc.name, err)
+		}
+	}
+}
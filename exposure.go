@@ -0,0 +1,73 @@
+package money
+
+import (
+	"math/big"
+	"time"
+)
+
+// Exposure is the position in a single currency of an ExposureReport.
+type Exposure struct {
+	// Money is the net position in its own currency.
+	Money *Money
+	// Rate is the rate used to convert Money to the base currency.
+	Rate *big.Rat
+	// Converted is Money converted to the base currency.
+	Converted *Money
+}
+
+// ExposureReport is a treasury report of positions held in many currencies, converted to
+// a base currency with the rates of a RateProvider taken at one point in time.
+type ExposureReport struct {
+	Base string
+	Time time.Time
+	// Exposures are the positions ordered by currency code.
+	Exposures []Exposure
+	// Total is the sum of the converted positions.
+	Total *Money
+	// Rates is the snapshot of rates used, keyed by currency code.
+	Rates map[string]*big.Rat
+}
+
+// NewExposureReport nets given positions per currency, e.g. the totals of a Bag, the
+// balances of Balances or of a journal account, and converts them to the base currency
+// with the rates of the RateProvider. Each position is converted and rounded with given
+// mode separately, so the Total is the sum of the converted positions. It returns the
+// errors of the RateProvider, and ErrOverflow when a position or the Total doesn't fit
+// into Amount.
+func NewExposureReport(positions []*Money, base string, rp RateProvider, mode RoundingMode) (*ExposureReport, error) {
+	bag, err := NewBag(positions...)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := resolveCode(base)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ExposureReport{
+		Base:      c.Code,
+		Time:      time.Now(),
+		Exposures: make([]Exposure, 0, bag.Len()),
+		Total:     &Money{Currency: c},
+		Rates:     make(map[string]*big.Rat, bag.Len()),
+	}
+
+	for _, m := range bag.Totals() {
+		cm, rate, err := Convert(m, c.Code, rp, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		total, ok := mutate.calc.addChecked(r.Total.Amount, cm.Amount)
+		if !ok {
+			return nil, ErrOverflow
+		}
+
+		r.Total.Amount = total
+		r.Rates[canonicalCode(m.Currency.Code)] = rate
+		r.Exposures = append(r.Exposures, Exposure{Money: m, Rate: rate, Converted: cm})
+	}
+
+	return r, nil
+}
@@ -0,0 +1,49 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestNewExposureReport(t *testing.T) {
+	positions := []*Money{New(1000, EUR), New(500, USD), New(-200, EUR), New(30000, JPY), nil}
+
+	r, err := NewExposureReport(positions, USD, testRates, RoundHalfEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		money, converted *Money
+		rate             *big.Rat
+	}{
+		{New(800, EUR), New(864, USD), big.NewRat(108, 100)},
+		{New(30000, JPY), New(20000, USD), big.NewRat(1, 150)},
+		{New(500, USD), New(500, USD), big.NewRat(1, 1)},
+	}
+
+	if len(r.Exposures) != len(expected) {
+		t.Fatalf("Expected %d exposures got %d", len(expected), len(r.Exposures))
+	}
+
+	for i, e := range expected {
+		got := r.Exposures[i]
+		if !got.Money.Equal(e.money) || !got.Converted.Equal(e.converted) || got.Rate.Cmp(e.rate) != 0 {
+			t.Errorf("Expected exposure %v = %v at %v got %v = %v at %v",
+				e.money.Display(), e.converted.Display(), e.rate, got.Money.Display(), got.Converted.Display(), got.Rate)
+		}
+
+		if r.Rates[got.Money.Currency.Code].Cmp(e.rate) != 0 {
+			t.Errorf("Expected rate snapshot %v for %s got %v", e.rate, got.Money.Currency.Code, r.Rates[got.Money.Currency.Code])
+		}
+	}
+
+	if r.Base != USD || !r.Total.Equal(New(21364, USD)) {
+		t.Errorf("Expected total %v got %v", New(21364, USD).Display(), r.Total.Display())
+	}
+
+	if _, err := NewExposureReport(positions, GBP, testRates, RoundHalfEven); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+}
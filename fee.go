@@ -0,0 +1,136 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// FeeBracket is a tier of a FeeSchedule, applying Rate to amounts from From upwards
+// until the next bracket.
+type FeeBracket struct {
+	// From is the lower bound of the bracket in minor units.
+	From Amount
+	// Rate is the fraction charged, e.g. 29/1000 for 2.9%.
+	Rate *big.Rat
+}
+
+// FeeSchedule computes fees of Money exactly, as a percentage plus a fixed component
+// bounded by a minimum and a cap. The percentage is either the flat Rate or taken from
+// Brackets. The rated fee is rounded once with Rounding before Fixed, Min and Max apply.
+//
+// Fixed, Min and Max hold at most one Money per Currency, so a single schedule serves
+// many currencies; Money of a Currency not listed has no such component.
+type FeeSchedule struct {
+	// Rate is the fraction charged when there are no Brackets. Nil means none.
+	Rate *big.Rat
+	// Brackets are the tiers of the schedule ordered by From, all starting at zero or above.
+	// Amounts below the first bracket aren't rated.
+	Brackets []FeeBracket
+	// Graduated charges each bracket's rate on the part of the amount within the bracket,
+	// like tax brackets. Otherwise the rate of the bracket the amount falls into is
+	// charged on the whole amount.
+	Graduated bool
+	Fixed     []*Money
+	Min       []*Money
+	Max       []*Money
+	Rounding  RoundingMode
+}
+
+// Apply returns the fee of Money and the net, Money less the fee. The net is negative
+// when a minimum fee is higher than Money. It returns ErrNilMoney, ErrInvalidAmount for
+// negative Money and ErrInvalidFeeSchedule for negative rates or unordered brackets.
+func (s *FeeSchedule) Apply(m *Money) (fee, net *Money, err error) {
+	if !m.IsValid() {
+		return nil, nil, ErrNilMoney
+	}
+
+	if m.IsNegative() {
+		return nil, nil, ErrInvalidAmount
+	}
+
+	r, err := s.rated(m.Amount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := roundQuo(r.Num(), r.Denom(), s.Rounding)
+	if !a.IsInt64() {
+		return nil, nil, ErrOverflow
+	}
+	fee = &Money{Amount: Amount(a.Int64()), Currency: m.Currency}
+
+	if f := feeComponent(s.Fixed, m.Currency); f != nil {
+		if fee, err = fee.Add(f); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if lo := feeComponent(s.Min, m.Currency); lo != nil && fee.Amount < lo.Amount {
+		fee.Amount = lo.Amount
+	}
+
+	if hi := feeComponent(s.Max, m.Currency); hi != nil && fee.Amount > hi.Amount {
+		fee.Amount = hi.Amount
+	}
+
+	if net, err = m.Subtract(fee); err != nil {
+		return nil, nil, err
+	}
+
+	return fee, net, nil
+}
+
+// rated returns the exact rated fee of given amount in minor units.
+func (s *FeeSchedule) rated(a Amount) (*big.Rat, error) {
+	amount := new(big.Rat).SetInt64(int64(a))
+	if len(s.Brackets) == 0 {
+		if s.Rate == nil {
+			return new(big.Rat), nil
+		}
+
+		if s.Rate.Sign() < 0 {
+			return nil, fmt.Errorf("%w: negative rate %s", ErrInvalidFeeSchedule, s.Rate.RatString())
+		}
+
+		return amount.Mul(amount, s.Rate), nil
+	}
+
+	for i, b := range s.Brackets {
+		if b.Rate == nil || b.Rate.Sign() < 0 || b.From < 0 || (i > 0 && b.From <= s.Brackets[i-1].From) {
+			return nil, fmt.Errorf("%w: bracket %d", ErrInvalidFeeSchedule, i)
+		}
+	}
+
+	fee := new(big.Rat)
+	for i, b := range s.Brackets {
+		if a < b.From {
+			break
+		}
+
+		switch {
+		case !s.Graduated:
+			fee.Mul(amount, b.Rate)
+		default:
+			top := a
+			if i+1 < len(s.Brackets) && s.Brackets[i+1].From < a {
+				top = s.Brackets[i+1].From
+			}
+
+			part := new(big.Rat).SetInt64(int64(top - b.From))
+			fee.Add(fee, part.Mul(part, b.Rate))
+		}
+	}
+
+	return fee, nil
+}
+
+// feeComponent returns the Money of given Currency, nil when there's none.
+func feeComponent(ms []*Money, c *Currency) *Money {
+	for _, m := range ms {
+		if m.IsValid() && m.Currency.equals(c) {
+			return m
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestFeeSchedule_Apply(t *testing.T) {
+	flat := &FeeSchedule{
+		Rate:  big.NewRat(29, 1000),
+		Fixed: []*Money{New(30, USD)},
+		Min:   []*Money{New(50, USD)},
+		Max:   []*Money{New(1000, USD)},
+	}
+
+	brackets := []FeeBracket{{0, big.NewRat(5, 100)}, {10000, big.NewRat(3, 100)}, {50000, big.NewRat(1, 100)}}
+	graduated := &FeeSchedule{Brackets: brackets, Graduated: true}
+	volume := &FeeSchedule{Brackets: brackets}
+
+	tcs := []struct {
+		schedule *FeeSchedule
+		money    *Money
+		fee, net Amount
+	}{
+		{flat, New(10000, USD), 320, 9680},
+		{flat, New(100, USD), 50, 50},
+		{flat, New(10, USD), 50, -40},
+		{flat, New(100000, USD), 1000, 99000},
+		{flat, New(10000, EUR), 290, 9710},
+		{graduated, New(5000, USD), 250, 4750},
+		{graduated, New(60000, USD), 1800, 58200},
+		{volume, New(5000, USD), 250, 4750},
+		{volume, New(60000, USD), 600, 59400},
+		{&FeeSchedule{Brackets: brackets[1:]}, New(5000, USD), 0, 5000},
+		{&FeeSchedule{Rate: big.NewRat(1, 1000), Rounding: RoundHalfEven}, New(2500, USD), 2, 2498},
+		{&FeeSchedule{Rate: big.NewRat(1, 1000), Rounding: RoundHalfEven}, New(1500, USD), 2, 1498},
+		{&FeeSchedule{}, New(1500, USD), 0, 1500},
+	}
+
+	for _, tc := range tcs {
+		fee, net, err := tc.schedule.Apply(tc.money)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if fee.Amount != tc.fee || net.Amount != tc.net || !fee.SameCurrency(tc.money) {
+			t.Errorf("Expected fee %d and net %d of %v got %v and %v", tc.fee, tc.net, tc.money, fee, net)
+		}
+	}
+}
+
+func TestFeeSchedule_ApplyErrors(t *testing.T) {
+	tcs := []struct {
+		schedule *FeeSchedule
+		money    *Money
+		err      error
+	}{
+		{&FeeSchedule{}, nil, ErrNilMoney},
+		{&FeeSchedule{}, New(-1, USD), ErrInvalidAmount},
+		{&FeeSchedule{Rate: big.NewRat(-1, 100)}, New(1, USD), ErrInvalidFeeSchedule},
+		{&FeeSchedule{Brackets: []FeeBracket{{100, big.NewRat(1, 100)}, {100, big.NewRat(1, 100)}}}, New(1, USD), ErrInvalidFeeSchedule},
+		{&FeeSchedule{Brackets: []FeeBracket{{0, nil}}}, New(1, USD), ErrInvalidFeeSchedule},
+		{&FeeSchedule{Rate: big.NewRat(2, 1)}, New(int64(MaxAmount), USD), ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		if _, _, err := tc.schedule.Apply(tc.money); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v got %v", tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package money
+
+import (
+	"math/big"
+	"strings"
+)
+
+// NegativeStyle defines how Money.Format marks negative amounts.
+type NegativeStyle int
+
+const (
+	// NegativeMinus marks negative amounts like Display, with a minus sign or the
+	// Negative template of the Formatter. It is the default.
+	NegativeMinus NegativeStyle = iota
+	// NegativeParentheses wraps negative amounts in parentheses like "($1,234.56)",
+	// as accounting statements do.
+	NegativeParentheses
+)
+
+// SymbolStyle defines how Money.Format shows the currency.
+type SymbolStyle int
+
+const (
+	// SymbolGrapheme shows the grapheme where the Template puts it, like Display. It is
+	// the default.
+	SymbolGrapheme SymbolStyle = iota
+	// SymbolCode shows the currency code where the Template puts the grapheme, separated
+	// from the number by a space, e.g. "USD 1,234.56" and "1.234,56 EUR".
+	SymbolCode
+	// SymbolCodePrefix shows the currency code before the number, e.g. "EUR 1.234,56".
+	SymbolCodePrefix
+	// SymbolCodeSuffix shows the currency code after the number, e.g. "1,234.56 USD".
+	SymbolCodeSuffix
+	// SymbolNone leaves the currency out, e.g. "1,234.56".
+	SymbolNone
+)
+
+// FormatOptions customizes how Money.Format displays Money. The zero value formats like
+// Display, the With methods return a copy with the option changed, e.g.
+//
+//	FormatOptions{}.WithNegative(NegativeParentheses).WithSymbol(SymbolCode)
+type FormatOptions struct {
+	negative    NegativeStyle
+	symbol      SymbolStyle
+	fraction    bool
+	minFraction int
+	maxFraction int
+	noGrouping  bool
+}
+
+// WithNegative returns FormatOptions marking negative amounts with given style.
+func (o FormatOptions) WithNegative(s NegativeStyle) FormatOptions {
+	o.negative = s
+	return o
+}
+
+// WithSymbol returns FormatOptions showing the currency with given style.
+func (o FormatOptions) WithSymbol(s SymbolStyle) FormatOptions {
+	o.symbol = s
+	return o
+}
+
+// WithFraction returns FormatOptions showing between minDigits and maxDigits fraction
+// digits instead of the fraction digits of the Currency. Digits beyond maxDigits are
+// rounded with the default RoundingMode, see SetDefaultRounding, and trailing zeros are
+// dropped down to minDigits, so WithFraction(0, 2) shows $12.00 as "$12" and $12.50 as
+// "$12.5". Negative values count as zero and minDigits is capped at maxDigits.
+func (o FormatOptions) WithFraction(minDigits, maxDigits int) FormatOptions {
+	o.fraction = true
+	o.minFraction, o.maxFraction = max(minDigits, 0), max(maxDigits, 0)
+	if o.minFraction > o.maxFraction {
+		o.minFraction = o.maxFraction
+	}
+
+	return o
+}
+
+// WithGrouping returns FormatOptions with the Thousand separators shown or left out.
+func (o FormatOptions) WithGrouping(on bool) FormatOptions {
+	o.noGrouping = !on
+	return o
+}
+
+// Format lets represent Money as string in its Currency customized by given options.
+func (m *Money) Format(opts FormatOptions) string {
+	c := m.Currency.get()
+	f := *c.Formatter()
+
+	digits := big.NewInt(int64(m.Amount))
+	if opts.fraction {
+		switch {
+		case opts.maxFraction < f.Fraction:
+			digits = roundQuo(digits, scale(f.Fraction-opts.maxFraction), GetDefaultRounding())
+		case opts.maxFraction > f.Fraction:
+			digits.Mul(digits, scale(opts.maxFraction-f.Fraction))
+		}
+		f.Fraction = opts.maxFraction
+
+		ten, r := big.NewInt(10), new(big.Int)
+		for f.Fraction > opts.minFraction {
+			q, rem := new(big.Int).QuoRem(digits, ten, r)
+			if rem.Sign() != 0 {
+				break
+			}
+			digits = q
+			f.Fraction--
+		}
+	}
+
+	if opts.noGrouping {
+		f.Thousand = ""
+	}
+
+	if opts.symbol != SymbolGrapheme {
+		f.Negative = ""
+		f.Grapheme = c.Code
+
+		switch opts.symbol {
+		case SymbolCode:
+			f.Template = strings.Replace(strings.Replace(f.Template, "$1", "$ 1", 1), "1$", "1 $", 1)
+		case SymbolCodePrefix:
+			f.Template = "$ 1"
+		case SymbolCodeSuffix:
+			f.Template = "1 $"
+		case SymbolNone:
+			f.Template = "1"
+		}
+	}
+
+	if opts.negative == NegativeParentheses && digits.Sign() < 0 {
+		f.Negative = ""
+		return "(" + f.FormatDigits(digits.Neg(digits).String()) + ")"
+	}
+
+	return f.FormatDigits(digits.String())
+}
@@ -0,0 +1,58 @@
+package money
+
+import "testing"
+
+func TestMoney_FormatOptions(t *testing.T) {
+	accounting := FormatOptions{}.WithNegative(NegativeParentheses)
+	euro := WithFormatter(NewFormatter(2, ",", ".", "€", "1 $"))
+
+	tcs := []struct {
+		m        *Money
+		opts     FormatOptions
+		expected string
+	}{
+		{New(-123456, USD), FormatOptions{}, "-$1,234.56"},
+		{New(-123456, USD), accounting, "($1,234.56)"},
+		{New(123456, USD), accounting, "$1,234.56"},
+		{New(-123456, USD), accounting.WithSymbol(SymbolCode), "(USD 1,234.56)"},
+		{New(123456, USD), FormatOptions{}.WithSymbol(SymbolCode), "USD 1,234.56"},
+		{New(123456, EUR, euro), FormatOptions{}.WithSymbol(SymbolCode), "1.234,56 EUR"},
+		{New(123456, EUR, euro), FormatOptions{}.WithSymbol(SymbolCodePrefix), "EUR 1.234,56"},
+		{New(-123456, USD), FormatOptions{}.WithSymbol(SymbolCodeSuffix), "-1,234.56 USD"},
+		{New(123456, USD), FormatOptions{}.WithSymbol(SymbolNone), "1,234.56"},
+		{New(123456, USD), FormatOptions{}.WithGrouping(false), "$1234.56"},
+		{New(123456, USD), FormatOptions{}.WithGrouping(false).WithGrouping(true), "$1,234.56"},
+		{New(1200, USD), FormatOptions{}.WithFraction(0, 2), "$12"},
+		{New(1250, USD), FormatOptions{}.WithFraction(0, 2), "$12.5"},
+		{New(1250, USD), FormatOptions{}.WithFraction(1, 2), "$12.5"},
+		{New(1200, USD), FormatOptions{}.WithFraction(1, 2), "$12.0"},
+		{New(1299, USD), FormatOptions{}.WithFraction(0, 0), "$13"},
+		{New(1234, USD), FormatOptions{}.WithFraction(4, 4), "$12.3400"},
+		{New(1234, JPY), FormatOptions{}.WithFraction(2, 2), "¥1,234.00"},
+		{New(-1, USD), accounting.WithFraction(0, 0), "$0"},
+		{New(1, USD), FormatOptions{}.WithFraction(3, 1), "$0.0"},
+		{New(int64(MaxAmount), USD), FormatOptions{}.WithFraction(0, 0).WithGrouping(false), "$92233720368547758"},
+		{New(int64(MinAmount), USD), accounting.WithSymbol(SymbolNone).WithFraction(3, 3), "(92,233,720,368,547,758.080)"},
+	}
+
+	for _, tc := range tcs {
+		if s := tc.m.Format(tc.opts); s != tc.expected {
+			t.Errorf("Expected %d %s formatted as %q got %q", tc.m.Amount, tc.m.Currency.Code, tc.expected, s)
+		}
+	}
+}
+
+func TestMoney_FormatRounding(t *testing.T) {
+	defer SetDefaultRounding(RoundHalfUp)
+
+	opts := FormatOptions{}.WithFraction(0, 0)
+	m := New(1250, USD)
+	if s := m.Format(opts); s != "$13" {
+		t.Errorf("Expected %q got %q", "$13", s)
+	}
+
+	SetDefaultRounding(RoundDown)
+	if s := m.Format(opts); s != "$12" {
+		t.Errorf("Expected %q got %q", "$12", s)
+	}
+}
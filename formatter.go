@@ -13,6 +13,9 @@ type Formatter struct {
 	Thousand string
 	Grapheme string
 	Template string
+	// Negative is the template of negative amounts, like Template with the sign in place,
+	// e.g. "$ -1". When empty a minus is put before the Template.
+	Negative string
 }
 
 // NewFormatter creates new Formatter instance.
@@ -28,8 +31,15 @@ func NewFormatter(fraction int, decimal, thousand, grapheme, template string) *F
 
 // Format returns string of formatted integer using given Currency template.
 func (f *Formatter) Format(amount int64) string {
+	return f.FormatDigits(strconv.FormatInt(amount, 10))
+}
+
+// FormatDigits works like Format but takes the amount in minor units as decimal digits
+// with an optional leading minus, e.g. from big.Int.String, so amounts that don't fit
+// into int64 can be formatted too.
+func (f *Formatter) FormatDigits(digits string) string {
 	// Work with absolute Amount value
-	sa := strconv.FormatInt(f.abs(amount), 10)
+	sa, neg := strings.CutPrefix(digits, "-")
 
 	if len(sa) <= f.Fraction {
 		sa = strings.Repeat("0", f.Fraction-len(sa)+1) + sa
@@ -44,11 +54,15 @@ func (f *Formatter) Format(amount int64) string {
 	if f.Fraction > 0 {
 		sa = sa[:len(sa)-f.Fraction] + f.Decimal + sa[len(sa)-f.Fraction:]
 	}
-	sa = strings.Replace(f.Template, "1", sa, 1)
+	tpl := f.Template
+	if neg && f.Negative != "" {
+		tpl = f.Negative
+	}
+	sa = strings.Replace(tpl, "1", sa, 1)
 	sa = strings.Replace(sa, "$", f.Grapheme, 1)
 
 	// Add minus sign for negative Amount.
-	if amount < 0 {
+	if neg && f.Negative == "" {
 		sa = "-" + sa
 	}
 
@@ -63,12 +77,3 @@ func (f *Formatter) ToMajorUnits(amount int64) float64 {
 
 	return float64(amount) / float64(math.Pow10(f.Fraction))
 }
-
-// abs return absolute value of given integer.
-func (f Formatter) abs(amount int64) int64 {
-	if amount < 0 {
-		return -amount
-	}
-
-	return amount
-}
@@ -0,0 +1,61 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Filter returns a new slice with the Money of given slice for which pred returns true,
+// keeping their order. All Money must have the same Currency, otherwise ErrCurrencyMismatch
+// is returned, so the result is always safe to aggregate. Nil values are skipped.
+func Filter(ms []*Money, pred func(*Money) bool) ([]*Money, error) {
+	if _, err := firstOfCurrency(ms); err != nil && !errors.Is(err, ErrNoValues) {
+		return nil, err
+	}
+
+	r := make([]*Money, 0, len(ms))
+	for _, m := range ms {
+		if m != nil && pred(m) {
+			r = append(r, m)
+		}
+	}
+
+	return r, nil
+}
+
+// Reduce folds given Money into a single Money by calling f with the accumulated value,
+// starting from init, and each Money in order. Every Money, as well as every value
+// returned by f, must have the Currency of init, otherwise ErrCurrencyMismatch is returned.
+// Errors returned by f stop the reduction. Nil values are skipped.
+func Reduce(ms []*Money, init *Money, f func(acc, m *Money) (*Money, error)) (*Money, error) {
+	if init == nil {
+		return nil, fmt.Errorf("%w: initial value must not be nil", ErrNilMoney)
+	}
+
+	acc := init
+	for _, m := range ms {
+		if m == nil {
+			continue
+		}
+
+		if err := init.assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+
+		r, err := f(acc, m)
+		if err != nil {
+			return nil, err
+		}
+
+		if r == nil {
+			return nil, fmt.Errorf("%w: reduce function must not return nil", ErrNilMoney)
+		}
+
+		if err := init.assertSameCurrency(r); err != nil {
+			return nil, err
+		}
+		acc = r
+	}
+
+	return acc, nil
+}
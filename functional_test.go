@@ -0,0 +1,53 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	ms := moneys(EUR, 100, -50, 0, 300)
+	ms = append(ms, nil)
+
+	r, err := Filter(ms, (*Money).IsPositive)
+	if expected := []int64{100, 300}; err != nil || !reflect.DeepEqual(amounts(r), expected) {
+		t.Errorf("Expected %v got %v (%v)", expected, amounts(r), err)
+	}
+
+	if r, err := Filter(nil, (*Money).IsPositive); err != nil || len(r) != 0 {
+		t.Errorf("Expected empty result got %v (%v)", r, err)
+	}
+
+	if _, err := Filter([]*Money{New(1, EUR), New(1, USD)}, (*Money).IsPositive); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	largest := func(acc, m *Money) (*Money, error) {
+		if m.Amount > acc.Amount {
+			return m, nil
+		}
+		return acc, nil
+	}
+
+	r, err := Reduce(moneys(EUR, 100, 700, 300), New(0, EUR), largest)
+	if err != nil || r.Amount != 700 {
+		t.Errorf("Expected %d got %v (%v)", 700, r, err)
+	}
+
+	r, err = Reduce(moneys(EUR, 100, 200), New(50, EUR), (*Money).Add)
+	if err != nil || r.Amount != 350 {
+		t.Errorf("Expected %d got %v (%v)", 350, r, err)
+	}
+
+	if _, err := Reduce(moneys(USD, 100), New(0, EUR), (*Money).Add); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	convert := func(acc, m *Money) (*Money, error) { return New(int64(m.Amount), USD), nil }
+	if _, err := Reduce(moneys(EUR, 100), New(0, EUR), convert); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
@@ -0,0 +1,157 @@
+package money
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func FuzzParseDecimal(f *testing.F) {
+	for _, s := range []string{"0", "-1234.56", "+1.5", "1.230", "99999999999999999999", "1.", ".5", "1e3"} {
+		f.Add(s, uint8(2))
+	}
+
+	f.Fuzz(func(t *testing.T, s string, fraction uint8) {
+		fr := int(fraction % 5)
+
+		a, err := parseDecimal(s, fr)
+		if err != nil {
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Expected ParseError for %q got %v", s, err)
+			}
+			return
+		}
+
+		r, err := parseDecimal(formatDecimal(a, fr), fr)
+		if err != nil || r != a {
+			t.Errorf("Expected %d for %q got %d (%v)", a, s, r, err)
+		}
+	})
+}
+
+func FuzzFormatDecimal(f *testing.F) {
+	f.Add(int64(0), uint8(2))
+	f.Add(int64(-123456), uint8(2))
+	f.Add(int64(1), uint8(3))
+	f.Add(int64(math.MinInt64), uint8(2))
+
+	f.Fuzz(func(t *testing.T, amount int64, fraction uint8) {
+		fr := int(fraction % 5)
+
+		s := formatDecimal(Amount(amount), fr)
+		r, err := parseDecimal(s, fr)
+		if err != nil || r != Amount(amount) {
+			t.Errorf("Expected %d for %q got %d (%v)", amount, s, r, err)
+		}
+	})
+}
+
+func FuzzFromKey(f *testing.F) {
+	for _, s := range []string{"USD:1234", "EUR:-1", ":1", "USD:", "A:B:1"} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, key string) {
+		m, err := FromKey(key)
+		if err != nil {
+			return
+		}
+
+		r, err := FromKey(m.Key())
+		if err != nil || !r.Equal(m) {
+			t.Errorf("Expected %v for %q got %v (%v)", m, key, r, err)
+		}
+	})
+}
+
+func FuzzParseQIFAmount(f *testing.F) {
+	for _, s := range []string{"T-1,234.56", "U1.234,56", "$0", ""} {
+		f.Add(s, ".")
+		f.Add(s, ",")
+	}
+
+	f.Fuzz(func(t *testing.T, s, decimal string) {
+		m, err := ParseQIFAmount(s, EUR, decimal)
+		if err == nil && m.Currency.Code != EUR {
+			t.Errorf("Expected %s got %s", EUR, m.Currency.Code)
+		}
+	})
+}
+
+func FuzzParseOFXTransaction(f *testing.F) {
+	f.Add("<STMTTRN><TRNAMT>-12.50<CURRENCY><CURSYM>EUR</CURRENCY></STMTTRN>", USD)
+	f.Add("<TRNAMT>1", USD)
+
+	f.Fuzz(func(t *testing.T, stmttrn, code string) {
+		_, _ = ParseOFXTransaction(stmttrn, code)
+	})
+}
+
+func FuzzISO20022Amount(f *testing.F) {
+	f.Add(`<InstdAmt Ccy="EUR">123.45</InstdAmt>`)
+	f.Add(`<InstdAmt Ccy="JPY">1</InstdAmt>`)
+
+	f.Fuzz(func(t *testing.T, s string) {
+		var a ISO20022Amount
+		if err := xml.NewDecoder(strings.NewReader(s)).Decode(&a); err != nil {
+			return
+		}
+
+		b, err := xml.Marshal(a)
+		if err != nil {
+			t.Fatalf("Expected %v to marshal got %v", a.Money, err)
+		}
+
+		var r ISO20022Amount
+		if err := xml.Unmarshal(b, &r); err != nil || !r.Equal(a.Money) {
+			t.Errorf("Expected %v for %s got %v (%v)", a.Money, b, r.Money, err)
+		}
+	})
+}
+
+func FuzzUnmarshalCanonical(f *testing.F) {
+	for _, m := range []*Money{New(1234, USD), New(-1, JPY), New(0, "XYZ")} {
+		for _, v := range []EncodingVersion{EncodingV1, EncodingV2} {
+			b, _ := m.AppendCanonical(nil, v)
+			f.Add(b)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		m, v, err := UnmarshalCanonical(data)
+		if err != nil {
+			return
+		}
+
+		b, err := m.AppendCanonical(nil, v)
+		if err != nil || !bytes.Equal(b, data) {
+			t.Errorf("Expected %x got %x (%v)", data, b, err)
+		}
+	})
+}
+
+func FuzzParse(f *testing.F) {
+	for _, s := range []string{"$1,234.56", "-$12", "$-0.5", "USD 1", "1,23", ""} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		m, err := Parse(s, USD)
+		if err != nil {
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Expected ParseError for %q got %v", s, err)
+			}
+			return
+		}
+
+		r, err := Parse(m.Display(), USD)
+		if err != nil || r.Amount != m.Amount {
+			t.Errorf("Expected %d for %q got %v (%v)", m.Amount, m.Display(), r, err)
+		}
+	})
+}
@@ -1,3 +1,3 @@
 module github.com/seth-duckinga/go-money
 
-go 1.22
+go 1.23
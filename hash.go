@@ -0,0 +1,48 @@
+package money
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+)
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// Hash returns a 64-bit FNV-1a hash of the currency code and the amount of Money.
+// The hash is stable across processes and releases, so it can be used for sharding
+// and consistent hashing. Equal Money always have the same hash.
+func (m *Money) Hash() uint64 {
+	code := canonicalCode(m.Currency.Code)
+
+	h := uint64(fnvOffset64)
+	for i := 0; i < len(code); i++ {
+		h ^= uint64(code[i])
+		h *= fnvPrime64
+	}
+
+	// Separate the code from the amount, so codes can't collide with amount bytes.
+	h ^= 0
+	h *= fnvPrime64
+
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(m.Amount))
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= fnvPrime64
+	}
+
+	return h
+}
+
+// WriteHash writes the currency code and the amount of Money into h,
+// for use with in-process hash tables built on hash/maphash.
+func (m *Money) WriteHash(h *maphash.Hash) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(m.Amount))
+
+	_, _ = h.WriteString(canonicalCode(m.Currency.Code))
+	_ = h.WriteByte(0)
+	_, _ = h.Write(b[:])
+}
@@ -0,0 +1,54 @@
+package money
+
+import (
+	"hash/fnv"
+	"hash/maphash"
+	"testing"
+)
+
+func TestMoney_Hash(t *testing.T) {
+	m := New(1234, USD)
+
+	f := fnv.New64a()
+	_, _ = f.Write([]byte{'U', 'S', 'D', 0, 0xd2, 0x04, 0, 0, 0, 0, 0, 0})
+	if h := m.Hash(); h != f.Sum64() {
+		t.Errorf("Expected FNV-1a hash %d got %d", f.Sum64(), h)
+	}
+
+	if m.Hash() != (&Money{Amount: 1234, Currency: &Currency{Code: USD}}).Hash() {
+		t.Error("Expected equal Money to have the same hash")
+	}
+
+	for _, code := range []string{"usd", " USD", "Usd\t"} {
+		o := &Money{Amount: 1234, Currency: &Currency{Code: code}}
+		if !m.SameCurrency(o) || m.Hash() != o.Hash() {
+			t.Errorf("Expected Money of %q to have the same hash as %s", code, USD)
+		}
+	}
+
+	if m.Hash() == New(1234, EUR).Hash() || m.Hash() == New(1235, USD).Hash() {
+		t.Error("Expected different Money to have different hashes")
+	}
+}
+
+func TestMoney_WriteHash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	sum := func(m *Money) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		m.WriteHash(&h)
+		return h.Sum64()
+	}
+
+	if sum(New(1234, USD)) != sum(New(1234, "usd")) {
+		t.Error("Expected equal Money to have the same hash")
+	}
+
+	if sum(New(1234, USD)) != sum(&Money{Amount: 1234, Currency: &Currency{Code: " usd "}}) {
+		t.Error("Expected Money of non-canonical code to have the same hash")
+	}
+
+	if sum(New(1234, USD)) == sum(New(1234, EUR)) {
+		t.Error("Expected different Money to have different hashes")
+	}
+}
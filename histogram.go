@@ -0,0 +1,64 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Bucket holds the count and the sum of Money falling into the range [Lower, Upper).
+// Lower is nil for the first bucket and Upper is nil for the last one.
+type Bucket struct {
+	Lower *Money
+	Upper *Money
+	Count int
+	Sum   *Money
+}
+
+// Bucketize assigns given Money into buckets delimited by given bounds and returns
+// the count and the sum of each bucket. Bounds must be strictly ascending, n bounds
+// define n+1 buckets: below the first bound, between each pair of bounds and from the
+// last bound on. All Money and bounds must share the same Currency. Nil values are skipped.
+func Bucketize(ms []*Money, bounds ...*Money) ([]Bucket, error) {
+	first, err := firstOfCurrency(append(append([]*Money{}, bounds...), ms...))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, b := range bounds {
+		if b == nil {
+			return nil, fmt.Errorf("%w: bucket bounds can't be nil", ErrNilMoney)
+		}
+
+		if i > 0 && b.compare(bounds[i-1]) <= 0 {
+			return nil, fmt.Errorf("%w: bucket bounds must be strictly ascending", ErrInvalidRange)
+		}
+	}
+
+	bs := make([]Bucket, len(bounds)+1)
+	for i := range bs {
+		bs[i].Sum = &Money{Currency: first.Currency}
+		if i > 0 {
+			bs[i].Lower = bounds[i-1]
+		}
+		if i < len(bounds) {
+			bs[i].Upper = bounds[i]
+		}
+	}
+
+	for _, m := range ms {
+		if m == nil {
+			continue
+		}
+
+		i := sort.Search(len(bounds), func(i int) bool { return bounds[i].compare(m) > 0 })
+
+		a, ok := mutate.calc.addChecked(bs[i].Sum.Amount, m.Amount)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		bs[i].Sum.Amount = a
+		bs[i].Count++
+	}
+
+	return bs, nil
+}
@@ -0,0 +1,60 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBucketize(t *testing.T) {
+	ms := moneys(USD, 500, 999, 1000, 5000, 9999, 10000, 25000, -100)
+	bs, err := Bucketize(ms, New(1000, USD), New(10000, USD))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		count int
+		sum   Amount
+	}{
+		{3, 1399},
+		{3, 15999},
+		{2, 35000},
+	}
+
+	if len(bs) != len(tcs) {
+		t.Fatalf("Expected %d buckets got %d", len(tcs), len(bs))
+	}
+
+	for i, tc := range tcs {
+		if bs[i].Count != tc.count || bs[i].Sum.Amount != tc.sum {
+			t.Errorf("Expected bucket %d to have %d values summing %d got %d summing %d",
+				i, tc.count, tc.sum, bs[i].Count, bs[i].Sum.Amount)
+		}
+	}
+
+	if bs[0].Lower != nil || bs[0].Upper.Amount != 1000 || bs[2].Lower.Amount != 10000 || bs[2].Upper != nil {
+		t.Errorf("Unexpected bucket bounds %+v", bs)
+	}
+}
+
+func TestBucketize_Errors(t *testing.T) {
+	ms := moneys(USD, 500)
+
+	if _, err := Bucketize(ms, New(1000, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := Bucketize(ms, New(1000, USD), New(1000, USD)); err == nil {
+		t.Error("Expected err for bounds not ascending")
+	}
+
+	if _, err := Bucketize(nil); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+
+	bs, err := Bucketize(nil, New(1000, USD))
+	if err != nil || len(bs) != 2 || bs[1].Sum.Currency.Code != USD {
+		t.Errorf("Expected 2 empty USD buckets got %+v (%v)", bs, err)
+	}
+}
@@ -0,0 +1,239 @@
+// Package installment builds installment schedules of loans and payment plans on top of
+// money.Money and computes what it takes to pay them off early.
+package installment
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var (
+	// ErrInvalidSchedule happens when a Schedule has no installments or a nil rate, or
+	// when amortizing over less than one installment.
+	ErrInvalidSchedule = errors.New("installment: invalid schedule")
+
+	// ErrBeforeStart happens when computing a payoff before the start of a Schedule.
+	ErrBeforeStart = errors.New("installment: date is before the start of the schedule")
+)
+
+// Installment is a scheduled payment, split into the interest of its period and the
+// principal it repays.
+type Installment struct {
+	Number    int
+	Due       time.Time
+	Payment   *money.Money
+	Principal *money.Money
+	Interest  *money.Money
+	// Balance is the principal left after the payment.
+	Balance *money.Money
+}
+
+// Schedule is a loan of Principal at the annual Rate repaid by monthly Installments.
+type Schedule struct {
+	Principal *money.Money
+	// Rate is the annual rate, e.g. 6/100 for 6%, charged at a twelfth per month.
+	Rate *big.Rat
+	// Start is the day the loan is taken out, a month before the first installment.
+	Start time.Time
+	// Rounding rounds the payments and interest to minor units.
+	Rounding     money.RoundingMode
+	Installments []Installment
+}
+
+// Amortize returns the Schedule of n level monthly installments repaying the principal
+// at the annual rate, the first due on given day and the others on the same day of the
+// following months, or on their last day when they are shorter. Payments and the
+// interest of each period are rounded with given mode; the last installment repays
+// whatever principal is left, so the principal parts always add up to the principal.
+// It returns ErrInvalidSchedule and money.ErrNilMoney.
+func Amortize(principal *money.Money, rate *big.Rat, n int, first time.Time, mode money.RoundingMode) (*Schedule, error) {
+	if !principal.IsValid() {
+		return nil, money.ErrNilMoney
+	}
+
+	if n < 1 || rate == nil || rate.Sign() < 0 {
+		return nil, ErrInvalidSchedule
+	}
+
+	r := monthly(rate)
+
+	// Level payment P*r / (1 - (1+r)^-n), or P/n without interest.
+	payment := new(big.Rat).Quo(principal.AsRat(), big.NewRat(int64(n), 1))
+	if r.Sign() != 0 {
+		f := new(big.Rat).Add(big.NewRat(1, 1), r)
+		pow := big.NewRat(1, 1)
+		for i := 0; i < n; i++ {
+			pow.Mul(pow, f)
+		}
+
+		payment.Mul(principal.AsRat(), r)
+		payment.Mul(payment, pow)
+		payment.Quo(payment, pow.Sub(pow, big.NewRat(1, 1)))
+	}
+
+	level, err := (&money.Money{Currency: principal.Currency}).SetRat(payment, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Schedule{Principal: principal, Rate: rate, Start: addMonths(first, -1), Rounding: mode, Installments: make([]Installment, 0, n)}
+	balance := principal
+	for i := 1; i <= n; i++ {
+		interest, err := (&money.Money{Currency: principal.Currency}).SetRat(new(big.Rat).Mul(balance.AsRat(), r), mode)
+		if err != nil {
+			return nil, err
+		}
+
+		pay := level
+		if i == n {
+			if pay, err = balance.Add(interest); err != nil {
+				return nil, err
+			}
+		}
+
+		part, _ := pay.Subtract(interest)
+		balance, _ = balance.Subtract(part)
+
+		s.Installments = append(s.Installments, Installment{
+			Number:    i,
+			Due:       addMonths(first, i-1),
+			Payment:   pay,
+			Principal: part,
+			Interest:  interest,
+			Balance:   balance,
+		})
+	}
+
+	return s, nil
+}
+
+// RebateMethod decides how much of the scheduled interest is rebated on early payoff.
+type RebateMethod int
+
+// Rebate methods supported by Schedule.Payoff.
+const (
+	// Actuarial charges the interest earned up to the payoff day: the remaining principal
+	// plus the interest of the current period accrued by days.
+	Actuarial RebateMethod = iota
+	// RuleOf78 rebates the sum-of-digits share of the total interest of the remaining
+	// installments, the method of precomputed loans. It favours the lender over Actuarial.
+	RuleOf78
+)
+
+// Payoff is the amount that pays off a Schedule early.
+type Payoff struct {
+	AsOf time.Time
+	// Paid is the number of installments due up to AsOf, which are assumed paid.
+	Paid int
+	// RemainingPayments is the sum of the installments not paid yet.
+	RemainingPayments *money.Money
+	// RemainingPrincipal is the principal left after the paid installments.
+	RemainingPrincipal *money.Money
+	// Rebate is the scheduled interest not charged by paying off early.
+	Rebate *money.Money
+	// Amount is RemainingPayments less Rebate, what pays the Schedule off.
+	Amount *money.Money
+}
+
+// Payoff computes the amount paying off the Schedule on given day, the installments due
+// up to and including it being paid. Interest is accrued by calendar days in the location
+// of Start and rounded with the Rounding of the Schedule. It returns ErrInvalidSchedule
+// and ErrBeforeStart.
+func (s *Schedule) Payoff(asOf time.Time, method RebateMethod) (*Payoff, error) {
+	n := len(s.Installments)
+	if n == 0 || s.Rate == nil || !s.Principal.IsValid() {
+		return nil, ErrInvalidSchedule
+	}
+
+	loc := s.Start.Location()
+	if days(s.Start, asOf, loc) < 0 {
+		return nil, ErrBeforeStart
+	}
+
+	zero := &money.Money{Currency: s.Principal.Currency}
+	p := &Payoff{AsOf: asOf, RemainingPayments: zero, RemainingPrincipal: s.Principal}
+	for _, in := range s.Installments {
+		if days(in.Due, asOf, loc) >= 0 {
+			p.Paid++
+			p.RemainingPrincipal = in.Balance
+			continue
+		}
+
+		var err error
+		if p.RemainingPayments, err = p.RemainingPayments.Add(in.Payment); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.Paid == n {
+		p.Rebate, p.Amount = zero, zero
+		return p, nil
+	}
+
+	switch method {
+	case RuleOf78:
+		total := new(big.Rat)
+		for _, in := range s.Installments {
+			total.Add(total, in.Interest.AsRat())
+		}
+
+		m := int64(n - p.Paid)
+		total.Mul(total, big.NewRat(m*(m+1), int64(n)*int64(n+1)))
+
+		rebate, err := (&money.Money{Currency: zero.Currency}).SetRat(total, s.Rounding)
+		if err != nil {
+			return nil, err
+		}
+		p.Rebate = rebate
+		p.Amount, _ = p.RemainingPayments.Subtract(rebate)
+	case Actuarial:
+		prev := s.Start
+		if p.Paid > 0 {
+			prev = s.Installments[p.Paid-1].Due
+		}
+		next := s.Installments[p.Paid].Due
+
+		accrual := new(big.Rat).Mul(p.RemainingPrincipal.AsRat(), monthly(s.Rate))
+		accrual.Mul(accrual, big.NewRat(int64(days(prev, asOf, loc)), int64(max(days(prev, next, loc), 1))))
+
+		accrued, err := (&money.Money{Currency: zero.Currency}).SetRat(accrual, s.Rounding)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.Amount, err = p.RemainingPrincipal.Add(accrued); err != nil {
+			return nil, err
+		}
+		p.Rebate, _ = p.RemainingPayments.Subtract(p.Amount)
+	default:
+		return nil, fmt.Errorf("%w: unknown rebate method %d", ErrInvalidSchedule, method)
+	}
+
+	return p, nil
+}
+
+// addMonths returns t moved by n months. The day is clamped to the last day of the
+// resulting month, so due dates at month ends never overflow into the next month.
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+
+	return first.AddDate(0, 0, min(d, first.AddDate(0, 1, -1).Day())-1)
+}
+
+// monthly returns the monthly rate of an annual rate.
+func monthly(rate *big.Rat) *big.Rat {
+	return new(big.Rat).Quo(rate, big.NewRat(12, 1))
+}
+
+// days returns the number of calendar days from a until b in given location.
+func days(a, b time.Time, loc *time.Location) int {
+	ay, am, ad := a.In(loc).Date()
+	by, bm, bd := b.In(loc).Date()
+
+	return int(time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+}
@@ -0,0 +1,146 @@
+package installment
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestAmortize(t *testing.T) {
+	s, err := Amortize(money.New(1000000, money.USD), big.NewRat(12, 100), 12, day(2026, 2, 1), money.RoundHalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.Installments) != 12 || !s.Start.Equal(day(2026, 1, 1)) {
+		t.Fatalf("Expected 12 installments from %s got %d from %s", day(2026, 1, 1), len(s.Installments), s.Start)
+	}
+
+	first := s.Installments[0]
+	if first.Payment.Amount != 88849 || first.Interest.Amount != 10000 || first.Principal.Amount != 78849 {
+		t.Errorf("Expected first installment of %d = %d + %d got %d = %d + %d",
+			88849, 78849, 10000, first.Payment.Amount, first.Principal.Amount, first.Interest.Amount)
+	}
+
+	var principal money.Amount
+	for i, in := range s.Installments {
+		principal += in.Principal.Amount
+		if in.Payment.Amount != in.Principal.Amount+in.Interest.Amount {
+			t.Errorf("Expected installment %d to balance got %v", in.Number, in)
+		}
+
+		if !in.Due.Equal(day(2026, time.Month(2+i), 1)) {
+			t.Errorf("Expected installment %d due on %s got %s", in.Number, day(2026, time.Month(2+i), 1), in.Due)
+		}
+	}
+
+	last := s.Installments[11]
+	if principal != 1000000 || !last.Balance.IsZero() {
+		t.Errorf("Expected principal to be repaid got %d with %d left", principal, last.Balance.Amount)
+	}
+
+	if d := last.Payment.Amount - first.Payment.Amount; d < -12 || d > 12 {
+		t.Errorf("Expected a level last payment got %d", last.Payment.Amount)
+	}
+
+	s, _ = Amortize(money.New(1000, money.USD), new(big.Rat), 3, day(2026, 2, 1), money.RoundHalfUp)
+	if s.Installments[0].Payment.Amount != 333 || s.Installments[2].Payment.Amount != 334 {
+		t.Errorf("Expected payments of %d and %d without interest got %v", 333, 334, s.Installments)
+	}
+
+	s, _ = Amortize(money.New(1000, money.USD), new(big.Rat), 3, day(2026, 2, 1), money.RoundUp)
+	if s.Installments[0].Payment.Amount != 334 || s.Installments[2].Payment.Amount != 332 {
+		t.Errorf("Expected payments of %d and %d rounded up got %v", 334, 332, s.Installments)
+	}
+}
+
+func TestAmortize_MonthEnd(t *testing.T) {
+	s, err := Amortize(money.New(400000, money.USD), big.NewRat(12, 100), 4, day(2025, 1, 31), money.RoundHalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !s.Start.Equal(day(2024, 12, 31)) {
+		t.Errorf("Expected start on %s got %s", day(2024, 12, 31), s.Start)
+	}
+
+	expected := []time.Time{day(2025, 1, 31), day(2025, 2, 28), day(2025, 3, 31), day(2025, 4, 30)}
+	for i, in := range s.Installments {
+		if !in.Due.Equal(expected[i]) {
+			t.Errorf("Expected installment %d due on %s got %s", in.Number, expected[i], in.Due)
+		}
+	}
+
+	s, _ = Amortize(money.New(400000, money.USD), big.NewRat(12, 100), 2, day(2025, 3, 31), money.RoundHalfUp)
+	if !s.Start.Equal(day(2025, 2, 28)) {
+		t.Errorf("Expected start on %s got %s", day(2025, 2, 28), s.Start)
+	}
+}
+
+func TestSchedule_Payoff(t *testing.T) {
+	s, _ := Amortize(money.New(1000000, money.USD), big.NewRat(12, 100), 12, day(2026, 2, 1), money.RoundHalfUp)
+	third := s.Installments[2]
+
+	var interest money.Amount
+	for _, in := range s.Installments {
+		interest += in.Interest.Amount
+	}
+
+	p, err := s.Payoff(third.Due, Actuarial)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Paid != 3 || p.Amount.Amount != third.Balance.Amount || p.RemainingPrincipal.Amount != third.Balance.Amount {
+		t.Errorf("Expected payoff of %d after 3 installments got %d after %d", third.Balance.Amount, p.Amount.Amount, p.Paid)
+	}
+
+	if p.RemainingPayments.Amount != p.Amount.Amount+p.Rebate.Amount {
+		t.Errorf("Expected payoff and rebate to balance the remaining payments got %v", p)
+	}
+
+	// 15 of the 30 days of April accrued.
+	mid, _ := s.Payoff(day(2026, 4, 16), Actuarial)
+	accrued := mid.Amount.Amount - third.Balance.Amount
+	if mid.Paid != 3 || accrued != (third.Balance.Amount+100)/200 {
+		t.Errorf("Expected %d accrued got %d", (third.Balance.Amount+100)/200, accrued)
+	}
+
+	r78, err := s.Payoff(third.Due, RuleOf78)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rebate := (interest*90 + 78) / 156
+	if r78.Rebate.Amount != rebate || r78.Amount.Amount != r78.RemainingPayments.Amount-rebate {
+		t.Errorf("Expected rebate of %d got %d", rebate, r78.Rebate.Amount)
+	}
+
+	if r78.Amount.Amount <= p.Amount.Amount {
+		t.Errorf("Expected rule of 78s payoff %d above actuarial %d", r78.Amount.Amount, p.Amount.Amount)
+	}
+
+	done, _ := s.Payoff(day(2027, 6, 1), RuleOf78)
+	if done.Paid != 12 || !done.Amount.IsZero() {
+		t.Errorf("Expected nothing to pay off got %v", done.Amount)
+	}
+
+	if _, err := s.Payoff(day(2025, 12, 31), Actuarial); !errors.Is(err, ErrBeforeStart) {
+		t.Errorf("Expected %v got %v", ErrBeforeStart, err)
+	}
+
+	if _, err := (&Schedule{}).Payoff(day(2026, 1, 1), Actuarial); !errors.Is(err, ErrInvalidSchedule) {
+		t.Errorf("Expected %v got %v", ErrInvalidSchedule, err)
+	}
+
+	if _, err := Amortize(money.New(1, money.USD), big.NewRat(1, 10), 0, day(2026, 1, 1), money.RoundHalfUp); !errors.Is(err, ErrInvalidSchedule) {
+		t.Errorf("Expected %v got %v", ErrInvalidSchedule, err)
+	}
+}
@@ -0,0 +1,283 @@
+// Package interest accrues interest on balances of money.Money for savings and loan
+// products. Interest accrues daily at the exact rational rate of the day-count convention
+// and is posted, rounded once, at the end of each posting period. The rounding residue is
+// carried into the next period, so posted interest never drifts from the exact accrual.
+//
+// Days are calendar days in the location of the start of the Balance.
+package interest
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var (
+	// ErrBackdated happens when accruing to or applying Money at a date before the date
+	// the Balance is accrued through.
+	ErrBackdated = errors.New("interest: date is before the accrued date")
+
+	// ErrInvalidRate happens when a Balance is created with a nil rate.
+	ErrInvalidRate = errors.New("interest: invalid rate")
+)
+
+// DayCount is the convention deciding which fraction of a year a day is.
+type DayCount int
+
+// Day-count conventions supported by Balance.
+const (
+	// Actual365Fixed counts every day as 1/365 of a year.
+	Actual365Fixed DayCount = iota
+	// Actual360 counts every day as 1/360 of a year.
+	Actual360
+	// ActualActual counts every day as 1/365 or 1/366 of a year depending on its year (ISDA).
+	ActualActual
+	// Thirty360 counts every month as 30 days of a 360 day year (30/360 bond basis), so the
+	// 31st accrues nothing and the end of February accrues the missing days.
+	Thirty360
+)
+
+var dayCountNames = [...]string{
+	Actual365Fixed: "ACT/365F",
+	Actual360:      "ACT/360",
+	ActualActual:   "ACT/ACT",
+	Thirty360:      "30/360",
+}
+
+// String returns the usual name of the convention.
+func (dc DayCount) String() string {
+	if dc < 0 || int(dc) >= len(dayCountNames) {
+		return fmt.Sprintf("DayCount(%d)", int(dc))
+	}
+
+	return dayCountNames[dc]
+}
+
+// fraction returns the fraction of a year from day until the next day.
+func (dc DayCount) fraction(day time.Time) *big.Rat {
+	switch dc {
+	case Actual360:
+		return big.NewRat(1, 360)
+	case ActualActual:
+		days := 365
+		if y := day.Year(); y%4 == 0 && (y%100 != 0 || y%400 == 0) {
+			days = 366
+		}
+		return big.NewRat(1, int64(days))
+	case Thirty360:
+		return big.NewRat(int64(thirty360(day, day.AddDate(0, 0, 1))), 360)
+	default:
+		return big.NewRat(1, 365)
+	}
+}
+
+// thirty360 returns the days from a until b by the 30/360 bond basis.
+func thirty360(a, b time.Time) int {
+	y1, m1, d1 := a.Date()
+	y2, m2, d2 := b.Date()
+
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 >= 30 {
+		d2 = 30
+	}
+
+	return 360*(y2-y1) + 30*(int(m2)-int(m1)) + d2 - d1
+}
+
+// Frequency is how often accrued interest is posted to a Balance.
+type Frequency int
+
+// Posting frequencies supported by Balance. Periods other than daily end at the end of
+// calendar months, quarters and years.
+const (
+	Daily Frequency = iota
+	Monthly
+	Quarterly
+	Annually
+)
+
+// ends reports whether a posting period ends before given day.
+func (f Frequency) ends(day time.Time) bool {
+	switch f {
+	case Monthly:
+		return day.Day() == 1
+	case Quarterly:
+		return day.Day() == 1 && (day.Month()-1)%3 == 0
+	case Annually:
+		return day.Day() == 1 && day.Month() == time.January
+	default:
+		return true
+	}
+}
+
+// Entry is the interest posted for a period.
+type Entry struct {
+	// From is the first day of the period, To the day after its last day.
+	From time.Time
+	To   time.Time
+	Days int
+	// Accrued is the exact interest of the period in major units, including the
+	// rounding residue carried from the previous period.
+	Accrued *big.Rat
+	// Interest is the posted interest, Accrued rounded.
+	Interest *money.Money
+	// Balance is the balance after posting Interest.
+	Balance *money.Money
+}
+
+// Balance is a balance accruing interest. Positive balances and rates earn interest,
+// e.g. savings, negative balances are charged interest, e.g. loans. Balance isn't safe
+// for concurrent use.
+type Balance struct {
+	balance  *money.Money
+	rate     *big.Rat
+	dayCount DayCount
+	freq     Frequency
+	rounding money.RoundingMode
+	loc      *time.Location
+	from     time.Time // first day of the current posting period
+	through  time.Time // day up to which interest is accrued, exclusive
+	accrued  *big.Rat  // unposted interest in major units
+}
+
+// NewBalance creates new Balance of opening Money accruing interest from given date at the
+// annual rate, e.g. 5/100 for 5% a year, with given convention and posting frequency.
+// Posted interest is rounded with given mode.
+func NewBalance(opening *money.Money, rate *big.Rat, dc DayCount, freq Frequency, mode money.RoundingMode, start time.Time) (*Balance, error) {
+	if !opening.IsValid() {
+		return nil, money.ErrNilMoney
+	}
+
+	if rate == nil {
+		return nil, ErrInvalidRate
+	}
+
+	loc := start.Location()
+	day := date(start, loc)
+
+	return &Balance{
+		balance:  opening,
+		rate:     new(big.Rat).Set(rate),
+		dayCount: dc,
+		freq:     freq,
+		rounding: mode,
+		loc:      loc,
+		from:     day,
+		through:  day,
+		accrued:  new(big.Rat),
+	}, nil
+}
+
+// Balance returns the balance including posted interest.
+func (b *Balance) Balance() *money.Money {
+	return b.balance
+}
+
+// Accrued returns the interest accrued but not posted yet, rounded.
+func (b *Balance) Accrued() *money.Money {
+	m, _ := (&money.Money{Currency: b.balance.Currency}).SetRat(b.accrued, b.rounding)
+	return m
+}
+
+// Through returns the day up to which interest is accrued, exclusive.
+func (b *Balance) Through() time.Time {
+	return b.local(b.through)
+}
+
+// AccrueTo accrues interest for each day up to the day of t, exclusive, and returns the
+// entries of the posting periods ended on the way. It returns ErrBackdated for days
+// before Through and money.ErrOverflow when the balance doesn't fit into money.Amount.
+func (b *Balance) AccrueTo(t time.Time) ([]Entry, error) {
+	end := date(t, b.loc)
+	if end.Before(b.through) {
+		return nil, ErrBackdated
+	}
+
+	var es []Entry
+	for b.through.Before(end) {
+		day := b.through
+		i := b.balance.AsRat()
+		i.Mul(i, b.rate).Mul(i, b.dayCount.fraction(day))
+		b.accrued.Add(b.accrued, i)
+		b.through = day.AddDate(0, 0, 1)
+
+		if !b.freq.ends(b.through) {
+			continue
+		}
+
+		e, err := b.post()
+		if err != nil {
+			return es, err
+		}
+		es = append(es, e)
+	}
+
+	return es, nil
+}
+
+// Apply accrues interest up to the day of t like AccrueTo and then adds Money to the
+// balance, e.g. a deposit, or a withdrawal when negative. Money applied on a day accrues
+// interest from that day on. It returns money.ErrCurrencyMismatch for Money of other
+// Currency besides the errors of AccrueTo.
+func (b *Balance) Apply(m *money.Money, t time.Time) ([]Entry, error) {
+	if _, err := b.balance.Compare(m); err != nil {
+		return nil, err
+	}
+
+	es, err := b.AccrueTo(t)
+	if err != nil {
+		return es, err
+	}
+
+	nb, err := b.balance.Add(m)
+	if err != nil {
+		return es, err
+	}
+	b.balance = nb
+
+	return es, nil
+}
+
+// post posts the accrued interest of the current period.
+func (b *Balance) post() (Entry, error) {
+	e := Entry{
+		From:    b.local(b.from),
+		To:      b.local(b.through),
+		Days:    int(b.through.Sub(b.from) / (24 * time.Hour)),
+		Accrued: new(big.Rat).Set(b.accrued),
+	}
+
+	interest, err := (&money.Money{Currency: b.balance.Currency}).SetRat(b.accrued, b.rounding)
+	if err != nil {
+		return e, err
+	}
+
+	nb, err := b.balance.Add(interest)
+	if err != nil {
+		return e, err
+	}
+
+	b.balance = nb
+	b.accrued.Sub(b.accrued, interest.AsRat())
+	b.from = b.through
+	e.Interest, e.Balance = interest, nb
+
+	return e, nil
+}
+
+// local returns the midnight of given UTC date in the location of the Balance.
+func (b *Balance) local(d time.Time) time.Time {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, b.loc)
+}
+
+// date returns the calendar day of t in given location as a UTC date, so adding days
+// is never affected by daylight saving changes.
+func date(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
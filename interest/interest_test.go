@@ -0,0 +1,148 @@
+package interest
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestBalance_AccrueTo(t *testing.T) {
+	b, err := NewBalance(money.New(3650000, money.USD), big.NewRat(1, 10), Actual365Fixed, Monthly, money.RoundHalfEven, day(2026, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	es, err := b.AccrueTo(day(2026, 3, 15))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		from     time.Time
+		days     int
+		interest money.Amount
+		balance  money.Amount
+	}{
+		{day(2026, 1, 1), 31, 31000, 3681000},
+		{day(2026, 2, 1), 28, 28238, 3709238},
+	}
+
+	if len(es) != len(expected) {
+		t.Fatalf("Expected %d entries got %d", len(expected), len(es))
+	}
+
+	for i, e := range expected {
+		got := es[i]
+		if !got.From.Equal(e.from) || got.Days != e.days || got.Interest.Amount != e.interest || got.Balance.Amount != e.balance {
+			t.Errorf("Expected entry %+v got %+v", e, got)
+		}
+	}
+
+	if !b.Through().Equal(day(2026, 3, 15)) || b.Balance().Amount != 3709238 || b.Accrued().Amount != 14227 {
+		t.Errorf("Expected balance %d with %d accrued through %s got %v with %v through %s",
+			3709238, 14227, day(2026, 3, 15), b.Balance(), b.Accrued(), b.Through())
+	}
+}
+
+func TestBalance_DayCount(t *testing.T) {
+	tcs := []struct {
+		dayCount DayCount
+		year     int
+		expected money.Amount
+	}{
+		{Actual365Fixed, 2026, 36000},
+		{Actual360, 2026, 36500},
+		{ActualActual, 2026, 36000},
+		{ActualActual, 2028, 36000},
+		{Actual365Fixed, 2028, 36099},
+		{Thirty360, 2026, 36000},
+		{Thirty360, 2028, 36000},
+	}
+
+	for _, tc := range tcs {
+		b, _ := NewBalance(money.New(360000, money.USD), big.NewRat(1, 10), tc.dayCount, Annually, money.RoundHalfEven, day(tc.year, 1, 1))
+		es, err := b.AccrueTo(day(tc.year+1, 1, 1))
+		if err != nil || len(es) != 1 || es[0].Interest.Amount != tc.expected {
+			t.Errorf("Expected %s interest of %d in %d got %v (%v)", tc.dayCount, tc.expected, tc.year, es, err)
+		}
+	}
+}
+
+func TestBalance_CarriesResidue(t *testing.T) {
+	b, _ := NewBalance(money.New(1000, money.USD), big.NewRat(1, 10), Actual365Fixed, Daily, money.RoundHalfEven, day(2026, 1, 1))
+
+	es, err := b.AccrueTo(day(2026, 1, 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []money.Amount{0, 1, 0, 0, 0, 1}
+	for i, e := range es {
+		if e.Interest.Amount != expected[i] || e.Days != 1 {
+			t.Errorf("Expected interest %d on day %d got %v", expected[i], i, e.Interest.Amount)
+		}
+	}
+}
+
+func TestBalance_Apply(t *testing.T) {
+	b, _ := NewBalance(money.New(-365000, money.EUR), big.NewRat(1, 10), Actual365Fixed, Monthly, money.RoundHalfEven, day(2026, 4, 1))
+
+	if _, err := b.Apply(money.New(182500, money.EUR), day(2026, 4, 11)); err != nil {
+		t.Fatal(err)
+	}
+
+	es, err := b.AccrueTo(day(2026, 5, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 10 days of -100 and 20 days of -50.
+	if len(es) != 1 || es[0].Interest.Amount != -2000 || es[0].Balance.Amount != -184500 {
+		t.Errorf("Expected interest %d and balance %d got %v", -2000, -184500, es)
+	}
+
+	if _, err := b.AccrueTo(day(2026, 4, 30)); !errors.Is(err, ErrBackdated) {
+		t.Errorf("Expected %v got %v", ErrBackdated, err)
+	}
+
+	if _, err := b.Apply(money.New(1, money.USD), day(2026, 5, 2)); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", money.ErrCurrencyMismatch, err)
+	}
+}
+
+func TestBalance_Location(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	b, _ := NewBalance(money.New(365000, money.USD), big.NewRat(1, 10), Actual365Fixed, Monthly, money.RoundHalfEven, time.Date(2026, 3, 1, 9, 0, 0, 0, ny))
+
+	// Still March 31st in New York.
+	es, _ := b.AccrueTo(time.Date(2026, 4, 1, 3, 0, 0, 0, time.UTC))
+	if len(es) != 0 {
+		t.Errorf("Expected no entries got %v", es)
+	}
+
+	es, _ = b.AccrueTo(time.Date(2026, 4, 1, 0, 0, 0, 0, ny))
+	if len(es) != 1 || es[0].Days != 31 || !es[0].To.Equal(time.Date(2026, 4, 1, 0, 0, 0, 0, ny)) {
+		t.Errorf("Expected a March entry of %d days got %v", 31, es)
+	}
+}
+
+func TestDayCount_String(t *testing.T) {
+	if s := Thirty360.String(); s != "30/360" {
+		t.Errorf("Expected %s got %s", "30/360", s)
+	}
+
+	if s := DayCount(9).String(); s != "DayCount(9)" {
+		t.Errorf("Expected %s got %s", "DayCount(9)", s)
+	}
+}
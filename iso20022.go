@@ -0,0 +1,62 @@
+package money
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// ISO20022Amount wraps Money to be marshaled as an ISO 20022 currency and amount
+// element (ActiveOrHistoricCurrencyAndAmount), e.g. <InstdAmt Ccy="EUR">123.45</InstdAmt>.
+// The element name is taken from the enclosing struct field.
+type ISO20022Amount struct {
+	*Money
+}
+
+// MarshalXML implements xml.Marshaler. ISO 20022 amounts can't be negative,
+// so ErrInvalidAmount is returned for negative Money.
+func (a ISO20022Amount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if a.Money == nil {
+		return nil
+	}
+
+	if a.IsNegative() {
+		return ErrInvalidAmount
+	}
+
+	c := a.Currency.get()
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "Ccy"}, Value: c.Code})
+
+	return e.EncodeElement(formatDecimal(a.Amount, c.Fraction), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (a *ISO20022Amount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var code string
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "Ccy" {
+			code = attr.Value
+		}
+	}
+
+	var v string
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	if code == "" || strings.HasPrefix(strings.TrimSpace(v), "-") {
+		return ErrInvalidAmount
+	}
+
+	m, err := NewWithOptions(0, code)
+	if err != nil {
+		return err
+	}
+	amount, err := parseDecimal(strings.TrimSpace(v), m.Currency.Fraction)
+	if err != nil {
+		return err
+	}
+	m.Amount = amount
+	a.Money = m
+
+	return nil
+}
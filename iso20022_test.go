@@ -0,0 +1,66 @@
+package money
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+type iso20022Tx struct {
+	XMLName  xml.Name       `xml:"CdtTrfTxInf"`
+	InstdAmt ISO20022Amount `xml:"InstdAmt"`
+}
+
+func TestISO20022Amount_MarshalXML(t *testing.T) {
+	tcs := []struct {
+		amount   Amount
+		code     string
+		expected string
+	}{
+		{12345, EUR, `<CdtTrfTxInf><InstdAmt Ccy="EUR">123.45</InstdAmt></CdtTrfTxInf>`},
+		{1500, JPY, `<CdtTrfTxInf><InstdAmt Ccy="JPY">1500</InstdAmt></CdtTrfTxInf>`},
+		{5, KWD, `<CdtTrfTxInf><InstdAmt Ccy="KWD">0.005</InstdAmt></CdtTrfTxInf>`},
+	}
+
+	for _, tc := range tcs {
+		b, err := xml.Marshal(iso20022Tx{InstdAmt: ISO20022Amount{New(int64(tc.amount), tc.code)}})
+
+		if err != nil || string(b) != tc.expected {
+			t.Errorf("Expected %s got %s (%v)", tc.expected, b, err)
+		}
+	}
+
+	_, err := xml.Marshal(iso20022Tx{InstdAmt: ISO20022Amount{New(-1, EUR)}})
+	if !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected %v got %v", ErrInvalidAmount, err)
+	}
+}
+
+func TestISO20022Amount_UnmarshalXML(t *testing.T) {
+	tcs := []struct {
+		xml    string
+		amount Amount
+		code   string
+		err    error
+	}{
+		{`<CdtTrfTxInf><InstdAmt Ccy="EUR">123.45</InstdAmt></CdtTrfTxInf>`, 12345, EUR, nil},
+		{`<CdtTrfTxInf><InstdAmt Ccy="EUR">10</InstdAmt></CdtTrfTxInf>`, 1000, EUR, nil},
+		{`<CdtTrfTxInf><InstdAmt Ccy="EUR">1.001</InstdAmt></CdtTrfTxInf>`, 0, "", ErrPrecisionLoss},
+		{`<CdtTrfTxInf><InstdAmt Ccy="EUR">-1.00</InstdAmt></CdtTrfTxInf>`, 0, "", ErrInvalidAmount},
+		{`<CdtTrfTxInf><InstdAmt>1.00</InstdAmt></CdtTrfTxInf>`, 0, "", ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		var tx iso20022Tx
+		err := xml.Unmarshal([]byte(tc.xml), &tx)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %s got %v", tc.err, tc.xml, err)
+			continue
+		}
+
+		if err == nil && (tx.InstdAmt.Amount != tc.amount || tx.InstdAmt.Currency.Code != tc.code) {
+			t.Errorf("Expected %d %s got %d %s", tc.amount, tc.code, tx.InstdAmt.Amount, tx.InstdAmt.Currency.Code)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+// Package journal implements a double-entry journal on top of money.Money. Transactions
+// are only recorded when their debits equal their credits in every currency, so the
+// balances of all accounts always sum to zero.
+package journal
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var (
+	// ErrUnbalanced happens when the debits of a Transaction don't equal its credits in a currency.
+	ErrUnbalanced = errors.New("journal: transaction doesn't balance")
+
+	// ErrTooFewPostings happens when a Transaction has less than two postings.
+	ErrTooFewPostings = errors.New("journal: transaction needs at least two postings")
+
+	// ErrUnknownAccount happens when a posting refers to an account which isn't open.
+	ErrUnknownAccount = errors.New("journal: unknown account")
+
+	// ErrAccountExists happens when an account is opened twice.
+	ErrAccountExists = errors.New("journal: account already exists")
+)
+
+// Account is the name of an account, e.g. "assets:cash".
+type Account string
+
+// Posting is one leg of a Transaction. Positive amounts debit the account, negative
+// ones credit it.
+type Posting struct {
+	Account Account
+	Amount  *money.Money
+}
+
+// Debit returns a Posting debiting given Money to the account.
+func Debit(a Account, m *money.Money) Posting {
+	return Posting{Account: a, Amount: m.Absolute()}
+}
+
+// Credit returns a Posting crediting given Money to the account.
+func Credit(a Account, m *money.Money) Posting {
+	return Posting{Account: a, Amount: m.Negative()}
+}
+
+// Transaction is a set of postings recorded together.
+type Transaction struct {
+	ID          string
+	Time        time.Time
+	Description string
+	Postings    []Posting
+}
+
+// Entry is a Posting along with the Transaction it belongs to, as returned by queries.
+type Entry struct {
+	Transaction *Transaction
+	Posting
+}
+
+// Journal records balanced transactions between its accounts. The zero value is an
+// empty Journal ready to use. Journal is safe for concurrent use.
+type Journal struct {
+	mu       sync.RWMutex
+	accounts map[Account]*money.Bag
+	txs      []*Transaction
+}
+
+// New creates new Journal with given accounts open.
+func New(accounts ...Account) (*Journal, error) {
+	j := &Journal{}
+	for _, a := range accounts {
+		if err := j.Open(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return j, nil
+}
+
+// Open opens an account with zero balances. It returns ErrAccountExists for accounts
+// which are open already.
+func (j *Journal) Open(a Account) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, ok := j.accounts[a]; ok {
+		return fmt.Errorf("%w: %s", ErrAccountExists, a)
+	}
+
+	if j.accounts == nil {
+		j.accounts = make(map[Account]*money.Bag)
+	}
+	j.accounts[a] = &money.Bag{}
+
+	return nil
+}
+
+// Accounts returns the sorted names of open accounts.
+func (j *Journal) Accounts() []Account {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	as := make([]Account, 0, len(j.accounts))
+	for a := range j.accounts {
+		as = append(as, a)
+	}
+	slices.Sort(as)
+
+	return as
+}
+
+// Post records the Transaction and updates the balances of its accounts. The Transaction
+// is rejected as a whole with ErrTooFewPostings, ErrUnknownAccount, money.ErrNilMoney,
+// ErrUnbalanced or money.ErrOverflow, leaving the Journal unchanged.
+func (j *Journal) Post(tx Transaction) error {
+	if len(tx.Postings) < 2 {
+		return ErrTooFewPostings
+	}
+
+	sum := &money.Bag{}
+	for _, p := range tx.Postings {
+		if !p.Amount.IsValid() {
+			return fmt.Errorf("%w: posting to %s", money.ErrNilMoney, p.Account)
+		}
+
+		if err := sum.Add(p.Amount); err != nil {
+			return err
+		}
+	}
+
+	if !sum.IsZero() {
+		return fmt.Errorf("%w: residue %v", ErrUnbalanced, display(sum.Totals()))
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	// Compute new balances first, so a failing posting doesn't leave the Journal half updated.
+	balances := make(map[Account]*money.Bag, len(tx.Postings))
+	for _, p := range tx.Postings {
+		b, ok := balances[p.Account]
+		if !ok {
+			cur, open := j.accounts[p.Account]
+			if !open {
+				return fmt.Errorf("%w: %s", ErrUnknownAccount, p.Account)
+			}
+
+			b = clone(cur)
+			balances[p.Account] = b
+		}
+
+		if err := b.Add(p.Amount); err != nil {
+			return err
+		}
+	}
+
+	for a, b := range balances {
+		j.accounts[a] = b
+	}
+
+	tx.Postings = slices.Clone(tx.Postings)
+	j.txs = append(j.txs, &tx)
+
+	return nil
+}
+
+// Balance returns the balance of the account in given currency, debits being positive.
+// It returns ErrUnknownAccount for accounts which aren't open.
+func (j *Journal) Balance(a Account, code string) (*money.Money, error) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	b, ok := j.accounts[a]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAccount, a)
+	}
+
+	return b.Get(code), nil
+}
+
+// Balances returns the balances of the account ordered by currency code.
+// It returns ErrUnknownAccount for accounts which aren't open.
+func (j *Journal) Balances(a Account) ([]*money.Money, error) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	b, ok := j.accounts[a]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAccount, a)
+	}
+
+	return b.Totals(), nil
+}
+
+// Transactions returns the recorded transactions in the order they were posted.
+// They are shared with the Journal and must not be modified.
+func (j *Journal) Transactions() []*Transaction {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	return slices.Clone(j.txs)
+}
+
+// Postings returns the entries of the account in the order they were posted, limited
+// to transactions with Time in [from, to). Zero from or to leave the range open.
+func (j *Journal) Postings(a Account, from, to time.Time) []Entry {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	var es []Entry
+	for _, tx := range j.txs {
+		if (!from.IsZero() && tx.Time.Before(from)) || (!to.IsZero() && !tx.Time.Before(to)) {
+			continue
+		}
+
+		for _, p := range tx.Postings {
+			if p.Account == a {
+				es = append(es, Entry{Transaction: tx, Posting: p})
+			}
+		}
+	}
+
+	return es
+}
+
+// clone returns a copy of the Bag.
+func clone(b *money.Bag) *money.Bag {
+	// Totals of distinct currencies never overflow when added to an empty Bag.
+	c, _ := money.NewBag(b.Totals()...)
+	return c
+}
+
+func display(ms []*money.Money) []string {
+	ds := make([]string, 0, len(ms))
+	for _, m := range ms {
+		if !m.IsZero() {
+			ds = append(ds, m.Display())
+		}
+	}
+
+	return ds
+}
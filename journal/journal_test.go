@@ -0,0 +1,94 @@
+package journal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+const (
+	cash    Account = "assets:cash"
+	bank    Account = "assets:bank"
+	revenue Account = "income:sales"
+)
+
+func TestJournal_Post(t *testing.T) {
+	j, _ := New(cash, bank, revenue)
+
+	err := j.Post(Transaction{ID: "1", Postings: []Posting{
+		Debit(cash, money.New(1000, money.EUR)),
+		Debit(bank, money.New(500, money.EUR)),
+		Credit(revenue, money.New(1500, money.EUR)),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		account  Account
+		expected money.Amount
+	}{
+		{cash, 1000},
+		{bank, 500},
+		{revenue, -1500},
+	}
+
+	for _, tc := range tcs {
+		if m, err := j.Balance(tc.account, money.EUR); err != nil || m.Amount != tc.expected {
+			t.Errorf("Expected balance of %s to be %d got %v (%v)", tc.account, tc.expected, m, err)
+		}
+	}
+}
+
+func TestJournal_PostRejected(t *testing.T) {
+	j, _ := New(cash, revenue)
+
+	tcs := []struct {
+		postings []Posting
+		err      error
+	}{
+		{[]Posting{Debit(cash, money.New(100, money.EUR))}, ErrTooFewPostings},
+		{[]Posting{Debit(cash, money.New(100, money.EUR)), Credit(revenue, money.New(99, money.EUR))}, ErrUnbalanced},
+		{[]Posting{Debit(cash, money.New(100, money.EUR)), Credit(revenue, money.New(100, money.USD))}, ErrUnbalanced},
+		{[]Posting{Debit(cash, money.New(100, money.EUR)), Credit(bank, money.New(100, money.EUR))}, ErrUnknownAccount},
+		{[]Posting{Debit(cash, money.New(100, money.EUR)), {Account: revenue}}, money.ErrNilMoney},
+	}
+
+	for _, tc := range tcs {
+		if err := j.Post(Transaction{Postings: tc.postings}); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v got %v", tc.err, err)
+		}
+	}
+
+	if m, _ := j.Balance(cash, money.EUR); !m.IsZero() || len(j.Transactions()) != 0 {
+		t.Errorf("Expected rejected transactions to leave the journal unchanged got %v", m)
+	}
+}
+
+func TestJournal_Postings(t *testing.T) {
+	j, _ := New(cash, revenue)
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		_ = j.Post(Transaction{ID: string(rune('a' + i)), Time: day.AddDate(0, 0, i), Postings: []Posting{
+			Debit(cash, money.New(100, money.EUR)),
+			Credit(revenue, money.New(60, money.EUR)),
+			Credit(revenue, money.New(40, money.EUR)),
+		}})
+	}
+
+	es := j.Postings(revenue, day.AddDate(0, 0, 1), day.AddDate(0, 0, 2))
+	if len(es) != 2 || es[0].Transaction.ID != "b" || es[0].Amount.Amount != -60 || es[1].Amount.Amount != -40 {
+		t.Errorf("Expected two postings of transaction %s got %+v", "b", es)
+	}
+
+	if es := j.Postings(cash, time.Time{}, time.Time{}); len(es) != 3 {
+		t.Errorf("Expected %d postings got %d", 3, len(es))
+	}
+
+	if err := j.Open(cash); !errors.Is(err, ErrAccountExists) {
+		t.Errorf("Expected %v got %v", ErrAccountExists, err)
+	}
+}
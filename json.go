@@ -0,0 +1,172 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+)
+
+// JSONFormat defines how Money is encoded by MarshalJSON.
+type JSONFormat int32
+
+const (
+	// JSONStruct encodes Money as its struct fields with the Currency as a nested object,
+	// e.g. {"amount":1099,"currency":{"code":"USD",...}}. It is the default.
+	JSONStruct JSONFormat = iota
+	// JSONCompact encodes Money as its amount in minor units as a string and its currency
+	// code, e.g. {"amount":"1099","currency":"USD"}, so consumers parsing JSON numbers
+	// as float64, like JavaScript, keep amounts beyond 2^53 exact.
+	JSONCompact
+)
+
+var jsonFormat atomic.Int32
+
+// SetJSONFormat sets the JSONFormat used by Money.MarshalJSON. It is safe to call
+// concurrently, but is meant to be called once during program initialization.
+func SetJSONFormat(f JSONFormat) {
+	jsonFormat.Store(int32(f))
+}
+
+// GetJSONFormat returns the JSONFormat used by Money.MarshalJSON.
+func GetJSONFormat() JSONFormat {
+	return JSONFormat(jsonFormat.Load())
+}
+
+// MarshalJSON implements json.Marshaler, encoding Money in the active JSONFormat. The
+// struct form is used rather than MarshalText, which encoding/json would use otherwise.
+func (m *Money) MarshalJSON() ([]byte, error) {
+	if GetJSONFormat() == JSONCompact {
+		return (*CompactMoney)(m).MarshalJSON()
+	}
+
+	type money Money
+	return json.Marshal((*money)(m))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts every JSONFormat regardless of
+// the active one: the amount as a number or a string of minor units and the currency as
+// a code or a nested object. Money is made Canonical. Malformed data and amounts which
+// aren't whole minor units are rejected with ErrInvalidJSONUnmarshal, unknown codes as
+// rejected by the UnknownCodePolicy.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var v struct {
+		Amount   json.RawMessage `json:"amount"`
+		Currency json.RawMessage `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, err)
+	}
+
+	a, err := unmarshalJSONAmount(v.Amount)
+	if err != nil {
+		return err
+	}
+
+	var c *Currency
+	switch {
+	case len(v.Currency) == 0 || string(v.Currency) == "null":
+	case v.Currency[0] == '"':
+		var code string
+		if err := json.Unmarshal(v.Currency, &code); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, err)
+		}
+
+		if code == "" {
+			return fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, ErrNilCurrency)
+		}
+
+		if c, err = resolveCode(code); err != nil {
+			return err
+		}
+	default:
+		c = &Currency{}
+		if err := json.Unmarshal(v.Currency, c); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, err)
+		}
+
+		if canonicalCode(c.Code) == "" {
+			return fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, ErrNilCurrency)
+		}
+
+		if c, err = resolveDecoded(c); err != nil {
+			return err
+		}
+	}
+
+	*m = *(&Money{Amount: a, Currency: c}).Canonical()
+
+	return nil
+}
+
+// resolveDecoded resolves the code of a Currency decoded as a nested object like codes
+// given on their own, so the UnknownCodePolicy applies to it, and fills its missing
+// fields from the Currency the code resolves to.
+func resolveDecoded(c *Currency) (*Currency, error) {
+	rc, err := resolveCode(c.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case c.resolved():
+		return c, nil
+	case *c == (Currency{Code: c.Code}):
+		return rc, nil
+	}
+
+	return c.fill(rc), nil
+}
+
+func unmarshalJSONAmount(raw json.RawMessage) (Amount, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0, nil
+	}
+
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, err)
+		}
+
+		a, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: amount %q", ErrInvalidJSONUnmarshal, s)
+		}
+
+		return Amount(a), nil
+	}
+
+	var a Amount
+	if err := json.Unmarshal(raw, &a); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, err)
+	}
+
+	return a, nil
+}
+
+// CompactMoney is Money encoded in JSONCompact regardless of SetJSONFormat, e.g. as the
+// field type of API responses. Convert with (*CompactMoney)(m) and (*Money)(cm).
+type CompactMoney Money
+
+// MarshalJSON implements json.Marshaler, encoding Money like {"amount":"1099","currency":"USD"}.
+// It returns ErrNilCurrency for Money without Currency.
+func (cm *CompactMoney) MarshalJSON() ([]byte, error) {
+	if cm.Currency == nil {
+		return nil, ErrNilCurrency
+	}
+
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{cm.Amount.String(), canonicalCode(cm.Currency.Code)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler like Money.UnmarshalJSON.
+func (cm *CompactMoney) UnmarshalJSON(data []byte) error {
+	return (*Money)(cm).UnmarshalJSON(data)
+}
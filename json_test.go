@@ -0,0 +1,109 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMoney_MarshalJSONFormat(t *testing.T) {
+	defer SetJSONFormat(JSONStruct)
+
+	m := New(9007199254740993, USD)
+	SetJSONFormat(JSONCompact)
+
+	b, err := json.Marshal(m)
+	if expected := `{"amount":"9007199254740993","currency":"USD"}`; err != nil || string(b) != expected {
+		t.Errorf("Expected %s got %s (%v)", expected, b, err)
+	}
+
+	var r Money
+	if err := json.Unmarshal(b, &r); err != nil || !r.EqualValue(m) {
+		t.Errorf("Expected %v got %v (%v)", m, &r, err)
+	}
+
+	if _, err := json.Marshal(&Money{Amount: 1}); !errors.Is(err, ErrNilCurrency) {
+		t.Errorf("Expected %v got %v", ErrNilCurrency, err)
+	}
+
+	SetJSONFormat(JSONStruct)
+	if b, err := json.Marshal(m); err != nil || b[len(`{"amount":`)] == '"' {
+		t.Errorf("Expected struct form got %s (%v)", b, err)
+	}
+}
+
+func TestCompactMoney(t *testing.T) {
+	type response struct {
+		Total *CompactMoney `json:"total"`
+	}
+
+	b, err := json.Marshal(response{(*CompactMoney)(New(-250, "eur"))})
+	if expected := `{"total":{"amount":"-250","currency":"EUR"}}`; err != nil || string(b) != expected {
+		t.Errorf("Expected %s got %s (%v)", expected, b, err)
+	}
+
+	var r response
+	if err := json.Unmarshal(b, &r); err != nil || !(*Money)(r.Total).EqualValue(New(-250, EUR)) {
+		t.Errorf("Expected %v got %v (%v)", New(-250, EUR), r.Total, err)
+	}
+}
+
+func TestMoney_UnmarshalJSONShapes(t *testing.T) {
+	tcs := []string{
+		`{"amount":1099,"currency":{"code":"USD","numericCode":"840","fraction":2,"grapheme":"$","template":"$1","decimal":".","thousand":","}}`,
+		`{"Amount":1099,"Currency":{"code":"usd"}}`,
+		`{"amount":1099,"currency":{"code":"USD","fraction":2}}`,
+		`{"amount":"1099","currency":"USD"}`,
+		`{"amount":1099,"currency":" usd "}`,
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := json.Unmarshal([]byte(tc), &m); err != nil || !m.EqualValue(New(1099, USD)) {
+			t.Errorf("Expected %s to decode to %v got %v (%v)", tc, New(1099, USD), &m, err)
+		}
+
+		if r, expected := m.Display(), "$10.99"; r != expected {
+			t.Errorf("Expected %s to display %s got %s", tc, expected, r)
+		}
+
+		b, err := json.Marshal(&m)
+		var r Money
+		if err != nil || json.Unmarshal(b, &r) != nil || r.Display() != "$10.99" || r.Currency.Grapheme != "$" {
+			t.Errorf("Expected %s to round-trip got %s (%v)", tc, b, err)
+		}
+	}
+}
+
+func TestMoney_UnmarshalJSONErrors(t *testing.T) {
+	tcs := []struct {
+		input string
+		err   error
+	}{
+		{`[]`, ErrInvalidJSONUnmarshal},
+		{`{"amount":"10.5","currency":"USD"}`, ErrInvalidJSONUnmarshal},
+		{`{"amount":"99999999999999999999","currency":"USD"}`, ErrInvalidJSONUnmarshal},
+		{`{"amount":true,"currency":"USD"}`, ErrInvalidJSONUnmarshal},
+		{`{"amount":"1","currency":""}`, ErrNilCurrency},
+		{`{"amount":"1","currency":1}`, ErrInvalidJSONUnmarshal},
+		{`{"amount":"1","currency":{"code":" "}}`, ErrNilCurrency},
+		{`{"amount":"1","currency":{"fraction":2}}`, ErrNilCurrency},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := json.Unmarshal([]byte(tc.input), &m); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %s got %v", tc.err, tc.input, err)
+		}
+	}
+
+	defer SetUnknownCodePolicy(nil)
+	SetUnknownCodePolicy(UnknownCodeError())
+
+	var m Money
+	for _, input := range []string{`{"amount":"1","currency":"XYZ"}`, `{"amount":1,"currency":{"code":"XYZ"}}`} {
+		if err := json.Unmarshal([]byte(input), &m); !errors.Is(err, ErrUnsupportedCurrency) {
+			t.Errorf("Expected %v for %s got %v", ErrUnsupportedCurrency, input, err)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package money
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Key returns a compact canonical representation of Money like "USD:1234", holding
+// the currency code and the amount in minor units. Equal Money always produce the
+// same key, so it can be used for map keys, deduplication and cache keys.
+func (m *Money) Key() string {
+	return canonicalCode(m.Currency.Code) + ":" + m.Amount.String()
+}
+
+// FromKey creates and returns new instance of Money from a key produced by Key.
+func FromKey(key string) (*Money, error) {
+	i := strings.LastIndexByte(key, ':')
+	if i <= 0 {
+		return nil, &ParseError{Input: key, Err: ErrInvalidAmount}
+	}
+
+	a, err := strconv.ParseInt(key[i+1:], 10, 64)
+	if err != nil {
+		return nil, &ParseError{Input: key, Err: ErrInvalidAmount}
+	}
+
+	return NewWithOptions(a, key[:i])
+}
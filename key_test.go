@@ -0,0 +1,38 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoney_Key(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{1234, USD, "USD:1234"},
+		{-5, "eur", "EUR:-5"},
+		{0, JPY, "JPY:0"},
+	}
+
+	for _, tc := range tcs {
+		k := New(tc.amount, tc.code).Key()
+		if k != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, k)
+		}
+
+		m, err := FromKey(k)
+		if err != nil || !m.Equal(New(tc.amount, tc.code)) {
+			t.Errorf("Expected %s to round trip got %v (%v)", k, m, err)
+		}
+	}
+}
+
+func TestFromKey_Invalid(t *testing.T) {
+	for _, k := range []string{"", "USD", ":12", "USD:", "USD:1.5", "USD:abc"} {
+		if _, err := FromKey(k); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected %v for %q got %v", ErrInvalidAmount, k, err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package money
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Ledger returns Money formatted as a ledger-cli posting amount, e.g. "-1234.56 USD".
+// The commodity is placed after the number and quoted when it contains
+// characters ledger doesn't accept in bare commodity names.
+func (m *Money) Ledger() string {
+	c := m.Currency.get()
+	code := c.Code
+
+	if strings.IndexFunc(code, func(r rune) bool { return r < 'A' || r > 'Z' }) >= 0 {
+		code = strconv.Quote(code)
+	}
+
+	return formatDecimal(m.Amount, c.Fraction) + " " + code
+}
+
+// Beancount returns Money formatted as a beancount posting amount, e.g. "-1234.56 USD".
+func (m *Money) Beancount() string {
+	c := m.Currency.get()
+	return formatDecimal(m.Amount, c.Fraction) + " " + c.Code
+}
@@ -0,0 +1,42 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestMoney_Ledger(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{123456, USD, "1234.56 USD"},
+		{-123456, EUR, "-1234.56 EUR"},
+		{1500, JPY, "1500 JPY"},
+		{-1, "POINTS2", `-0.01 "POINTS2"`},
+	}
+
+	for _, tc := range tcs {
+		if r := New(tc.amount, tc.code).Ledger(); r != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_Beancount(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{123456, USD, "1234.56 USD"},
+		{-5, GBP, "-0.05 GBP"},
+		{1, KWD, "0.001 KWD"},
+	}
+
+	for _, tc := range tcs {
+		if r := New(tc.amount, tc.code).Beancount(); r != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r)
+		}
+	}
+}
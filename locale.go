@@ -0,0 +1,153 @@
+package money
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Locale holds how a locale displays amounts of money, regardless of their currency:
+// its separators and where it puts the currency symbol and the sign. Templates are like
+// the Template of Currency, "1" stands for the number and "$" for the symbol.
+type Locale struct {
+	Decimal  string
+	Thousand string
+	Template string
+	// Negative is the template of negative amounts, see Formatter.
+	Negative string
+}
+
+// The locale table is swapped atomically like the currency registry. The embedded
+// locales follow the currency formats of CLDR, with the no-break spaces it uses.
+var (
+	localesMu sync.Mutex
+	locales   atomic.Pointer[map[string]Locale]
+
+	// defaultRegions picks the locale of a tag holding only its language.
+	defaultRegions = map[string]string{
+		"da": "da-DK", "de": "de-DE", "en": "en-US", "es": "es-ES", "fi": "fi-FI", "fr": "fr-FR",
+		"it": "it-IT", "ja": "ja-JP", "nb": "nb-NO", "nl": "nl-NL", "pl": "pl-PL", "pt": "pt-BR",
+		"ru": "ru-RU", "sv": "sv-SE", "zh": "zh-CN",
+	}
+)
+
+func init() {
+	const (
+		nbsp  = "\u00a0"
+		nnbsp = "\u202f"
+		minus = "\u2212"
+	)
+
+	english := Locale{Decimal: ".", Thousand: ",", Template: "$1", Negative: "-$1"}
+	suffixed := func(thousand string) Locale {
+		return Locale{Decimal: ",", Thousand: thousand, Template: "1" + nbsp + "$", Negative: "-1" + nbsp + "$"}
+	}
+	nordic := func(thousand string) Locale {
+		return Locale{Decimal: ",", Thousand: thousand, Template: "1" + nbsp + "$", Negative: minus + "1" + nbsp + "$"}
+	}
+
+	ls := map[string]Locale{
+		"da-DK": suffixed("."),
+		"de-AT": {Decimal: ",", Thousand: nbsp, Template: "$" + nbsp + "1", Negative: "-$" + nbsp + "1"},
+		"de-CH": {Decimal: ".", Thousand: "\u2019", Template: "$" + nbsp + "1", Negative: "$-1"},
+		"de-DE": suffixed("."),
+		"en-AU": english,
+		"en-CA": english,
+		"en-GB": english,
+		"en-IE": english,
+		"en-US": english,
+		"es-ES": suffixed("."),
+		"fi-FI": nordic(nbsp),
+		"fr-CA": suffixed(nbsp),
+		"fr-CH": suffixed(nnbsp),
+		"fr-FR": suffixed(nnbsp),
+		"it-IT": suffixed("."),
+		"ja-JP": english,
+		"nb-NO": nordic(nbsp),
+		"nl-NL": {Decimal: ",", Thousand: ".", Template: "$" + nbsp + "1", Negative: "$" + nbsp + "-1"},
+		"pl-PL": suffixed(nbsp),
+		"pt-BR": {Decimal: ",", Thousand: ".", Template: "$" + nbsp + "1", Negative: "-$" + nbsp + "1"},
+		"pt-PT": suffixed(nbsp),
+		"ru-RU": suffixed(nbsp),
+		"sv-SE": nordic(nbsp),
+		"zh-CN": english,
+	}
+	locales.Store(&ls)
+}
+
+// GetLocale returns the Locale of given BCP 47 tag like "de-DE" or "de_de" and whether
+// it's known. A tag holding only its language, like "de", gets the locale of the main
+// region of the language.
+func GetLocale(tag string) (Locale, bool) {
+	tag = canonicalLocale(tag)
+	ls := *locales.Load()
+	if l, ok := ls[tag]; ok {
+		return l, true
+	}
+
+	l, ok := ls[defaultRegions[tag]]
+	return l, ok
+}
+
+// RegisterLocale sets the Locale of given tag, adding locales or replacing embedded ones.
+func RegisterLocale(tag string, l Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	ls := maps.Clone(*locales.Load())
+	ls[canonicalLocale(tag)] = l
+	locales.Store(&ls)
+}
+
+// WithLocale returns a copy of the Formatter displaying amounts the way given locale
+// does, keeping its fraction digits and grapheme. It returns ErrUnknownLocale for
+// locales not known, see GetLocale.
+func (f *Formatter) WithLocale(tag string) (*Formatter, error) {
+	l, ok := GetLocale(tag)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLocale, tag)
+	}
+
+	lf := *f
+	lf.Decimal, lf.Thousand, lf.Template, lf.Negative = l.Decimal, l.Thousand, l.Template, l.Negative
+
+	return &lf, nil
+}
+
+// DisplayIn works like Display, displaying Money the way given locale does, e.g.
+// "1.234,56 €" for "de-DE" and "€1,234.56" for "en-IE". Money is displayed like Display
+// does for locales not known, see GetLocale.
+func (m *Money) DisplayIn(tag string) string {
+	f := m.Currency.get().Formatter()
+	if lf, err := f.WithLocale(tag); err == nil {
+		f = lf
+	}
+
+	return f.Format(int64(m.Amount))
+}
+
+// LocaleFormatter returns a Config Formatter displaying Money the way given locale does,
+// like DisplayIn, e.g. to follow the locale of a request.
+func LocaleFormatter(tag string) func(c *Currency) *Formatter {
+	return func(c *Currency) *Formatter {
+		f := c.Formatter()
+		if lf, err := f.WithLocale(tag); err == nil {
+			return lf
+		}
+
+		return f
+	}
+}
+
+// canonicalLocale returns the tag with a lower-cased language and an upper-cased region
+// separated by a hyphen, e.g. "de-DE" for "de_de".
+func canonicalLocale(tag string) string {
+	lang, region, ok := strings.Cut(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	if !ok {
+		return strings.ToLower(lang)
+	}
+
+	return strings.ToLower(lang) + "-" + strings.ToUpper(region)
+}
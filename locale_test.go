@@ -0,0 +1,74 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMoney_DisplayIn(t *testing.T) {
+	tcs := []struct {
+		m        *Money
+		tag      string
+		expected string
+	}{
+		{New(123456, EUR), "de-DE", "1.234,56\u00a0€"},
+		{New(123456, EUR), "fr-FR", "1\u202f234,56\u00a0€"},
+		{New(123456, EUR), "en-IE", "€1,234.56"},
+		{New(-123456, EUR), "en-IE", "-€1,234.56"},
+		{New(-123456, EUR), "de-DE", "-1.234,56\u00a0€"},
+		{New(-123456, EUR), "nl-NL", "€\u00a0-1.234,56"},
+		{New(-123456, EUR), "sv-SE", "\u22121\u00a0234,56\u00a0€"},
+		{New(-123456, CHF), "de-CH", "CHF-1\u2019234.56"},
+		{New(123456, JPY), "de_de", "123.456\u00a0¥"},
+		{New(123456, EUR), "DE", "1.234,56\u00a0€"},
+		{New(123456, EUR), "xx-XX", "€1,234.56"},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.m.DisplayIn(tc.tag); r != tc.expected {
+			t.Errorf("Expected %v in %s to be %q got %q", tc.m.Amount, tc.tag, tc.expected, r)
+		}
+	}
+}
+
+func TestFormatter_WithLocale(t *testing.T) {
+	f := New(0, EUR).Currency.Formatter()
+
+	// Every locale displays amounts which parse back.
+	for tag := range *locales.Load() {
+		lf, err := f.WithLocale(tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, a := range []int64{0, 5, -5, 123456789, -123456789} {
+			if r, err := lf.Parse(lf.Format(a)); err != nil || r != a {
+				t.Errorf("Expected %q in %s to parse to %d got %d (%v)", lf.Format(a), tag, a, r, err)
+			}
+		}
+	}
+
+	if _, err := f.WithLocale("tlh"); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("Expected %v got %v", ErrUnknownLocale, err)
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	old := locales.Load()
+	defer locales.Store(old)
+
+	RegisterLocale("en_in", Locale{Decimal: ".", Thousand: ",", Template: "$ 1", Negative: "-$ 1"})
+
+	if r, expected := New(-1050, "INR").DisplayIn("en-IN"), "-₹ 10.50"; r != expected {
+		t.Errorf("Expected %q got %q", expected, r)
+	}
+}
+
+func TestLocaleFormatter(t *testing.T) {
+	ctx := WithConfig(context.Background(), Config{Formatter: LocaleFormatter("fr-FR")})
+
+	if r, expected := New(150, EUR).DisplayContext(ctx), "1,50\u00a0€"; r != expected {
+		t.Errorf("Expected %q got %q", expected, r)
+	}
+}
@@ -2,58 +2,264 @@ package money
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"math/big"
+	"strconv"
+	"strings"
 )
 
-// Injection points for backward compatibility.
-// If you need to keep your JSON marshal/unmarshal way, overwrite them like below.
-//
-//	money.UnmarshalJSON = func (m *Money, b []byte) error { ... }
-//	money.MarshalJSON = func (m Money) ([]byte, error) { ... }
 var (
 	// ErrCurrencyMismatch happens when two compared Money don't have the same Currency.
+	// Operations return it as CurrencyMismatchError carrying both currency codes.
 	ErrCurrencyMismatch = errors.New("currencies don't match")
 
-	// ErrInvalidJSONUnmarshal happens when the default money.UnmarshalJSON fails to unmarshal Money because of invalid data.
+	// ErrInvalidJSONUnmarshal happens when Money can't be unmarshaled from JSON because of invalid data.
 	ErrInvalidJSONUnmarshal = errors.New("invalid json unmarshal")
+
+	// ErrInvalidAmount happens when an amount string can't be parsed.
+	ErrInvalidAmount = errors.New("invalid amount")
+
+	// ErrPrecisionLoss happens when an amount can't be represented with the requested number of fraction digits.
+	ErrPrecisionLoss = errors.New("amount can't be represented without losing precision")
+
+	// ErrOverflow happens when the result of an operation doesn't fit into Amount.
+	ErrOverflow = errors.New("amount overflow")
+
+	// ErrUnsupportedCurrency happens when a Currency isn't supported by the target format or provider.
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+
+	// ErrNoValues happens when an aggregation is done over no Money.
+	ErrNoValues = errors.New("no money values given")
+
+	// ErrInvalidRange happens when a Range has no bounds, bounds of different currencies or Min above Max.
+	ErrInvalidRange = errors.New("invalid money range")
+
+	// ErrInsufficientFunds happens when a debit would take a balance below zero where it's not allowed.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+
+	// ErrInvalidRatio happens when Money is split or allocated by an invalid number of parties or ratios,
+	// or aggregated with an invalid percentile, number of values or weights.
+	ErrInvalidRatio = errors.New("invalid ratio")
+
+	// ErrInvalidAllocation happens when allocated parts don't add up to the original Money or break their bounds.
+	ErrInvalidAllocation = errors.New("invalid allocation")
+
+	// ErrUnknownDataset happens when selecting a currency dataset version which isn't registered.
+	ErrUnknownDataset = errors.New("unknown currency dataset")
+
+	// ErrNilMoney happens when an operation is given nil Money.
+	ErrNilMoney = errors.New("nil money")
+
+	// ErrNilCurrency happens when an operation is given Money without Currency, e.g. the zero value
+	// or a partially deserialized struct.
+	ErrNilCurrency = errors.New("money has no currency")
+
+	// ErrHoldClosed happens when a Hold is captured or released after it was closed already.
+	ErrHoldClosed = errors.New("hold is already captured or released")
+
+	// ErrExceedsAuthorization happens when a capture is higher than the uncaptured authorized amount.
+	ErrExceedsAuthorization = errors.New("capture exceeds authorized amount")
+
+	// ErrExceedsCapture happens when a refund is higher than the captured amount not refunded yet.
+	ErrExceedsCapture = errors.New("refund exceeds captured amount")
+
+	// ErrAuthorizationVoided happens when capturing an Authorization after it was voided.
+	ErrAuthorizationVoided = errors.New("authorization is voided")
+
+	// ErrInvalidFeeSchedule happens when a FeeSchedule has negative rates or unordered brackets.
+	ErrInvalidFeeSchedule = errors.New("invalid fee schedule")
+
+	// ErrNoRate happens when a RateProvider has no exchange rate between two currencies.
+	ErrNoRate = errors.New("no exchange rate")
+
+	// ErrInvalidEncoding happens when Money can't be decoded from its canonical encoding.
+	ErrInvalidEncoding = errors.New("invalid canonical encoding")
+
+	// ErrInvalidCurrency happens when a Currency can't be registered, e.g. for a negative fraction.
+	ErrInvalidCurrency = errors.New("invalid currency")
+
+	// ErrCurrencyExists happens when registering a Currency whose code is registered already.
+	ErrCurrencyExists = errors.New("currency already registered")
+
+	// ErrUnknownLocale happens when no Locale is known for a locale tag.
+	ErrUnknownLocale = errors.New("unknown locale")
 )
 
 // Amount is a data structure that stores the Amount being used for calculations.
-type Amount = int64
+// It holds minor units, so signatures can tell them apart from arbitrary int64 values.
+// Untyped constants convert implicitly, other integers with Amount(v) and int64(a).
+type Amount int64
+
+// Abs returns the absolute value of the Amount. The absolute value of MinAmount
+// doesn't fit into Amount and is returned unchanged.
+func (a Amount) Abs() Amount {
+	return mutate.calc.absolute(a)
+}
+
+// Cmp compares the Amount with the other and returns -1, 0 or +1 when it's
+// lower, equal or higher.
+func (a Amount) Cmp(o Amount) int {
+	switch {
+	case a < o:
+		return -1
+	case a > o:
+		return 1
+	}
+
+	return 0
+}
+
+// String returns the Amount in minor units, e.g. "-1234".
+func (a Amount) String() string {
+	return strconv.FormatInt(int64(a), 10)
+}
 
 // Money represents monetary value information, stores
 // Currency and Amount value.
+//
+// Money created from a code gets its own copy of the registered Currency, so modifying
+// it never affects the registry, and so do the parties returned by Split and Allocate.
+// Other operations never modify their operands and always return new Money, but the
+// returned Money shares the *Currency of the operand, use Clone to detach it.
+//
+// The zero value of Money has no Currency and isn't valid, see IsValid. Operations
+// returning an error fail on it with ErrNilCurrency, and on nil Money with ErrNilMoney,
+// it never matches any Currency and formatting treats it as a currency with 2 decimals
+// and no symbol.
 type Money struct {
 	Amount   Amount    `json:"amount" bson:"amount"`
 	Currency *Currency `json:"currency" bson:"currency"`
 }
 
 // New creates and returns new instance of Money.
-func New(amount int64, code string) *Money {
-	return &Money{
-		Amount:   amount,
-		Currency: newCurrency(code).get(),
+// Options customize the Currency of the Money, see Option. New panics when an option fails
+// or the UnknownCodePolicy rejects the code, use NewWithOptions to get the error instead.
+func New(amount int64, code string, opts ...Option) *Money {
+	if len(opts) == 0 {
+		c, err := resolveCode(code)
+		if err != nil {
+			panic(err)
+		}
+
+		return &Money{Amount: Amount(amount), Currency: c}
 	}
+
+	m, err := NewWithOptions(amount, code, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// NewChecked creates and returns new instance of Money like New, but returns
+// UnsupportedCurrencyError for codes not found in the registry instead of falling
+// back to a default Currency, so typos like "USS" surface at creation time.
+func NewChecked(amount int64, code string) (*Money, error) {
+	return NewWithOptions(amount, code, WithStrictCode())
+}
+
+// NewStrict creates and returns new instance of Money like NewChecked, but first checks
+// that the code has the form of an ISO 4217 code, three letters after trimming and
+// upper-casing, returning ErrInvalidCurrency otherwise. Use NewChecked for registered
+// currencies with codes of other forms.
+func NewStrict(amount int64, code string) (*Money, error) {
+	if c := canonicalCode(code); len(c) != 3 || strings.Trim(c, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return nil, fmt.Errorf("%w: code %q", ErrInvalidCurrency, code)
+	}
+
+	return NewChecked(amount, code)
 }
 
-// NewFromFloat creates and returns new instance of Money from a float64.
-// Always rounding trailing decimals down.
+// NewFromFloat creates and returns new instance of Money from a float64, rounded to
+// minor units with the default RoundingMode, see SetDefaultRounding. Use
+// NewFromFloatChecked to choose the mode and get errors for floats which can't be
+// represented.
 func NewFromFloat(amount float64, currency string) *Money {
 	currencyDecimals := math.Pow10(GetCurrency(currency).Fraction)
-	return New(int64(math.Round(amount*currencyDecimals)), currency)
+	return New(int64(roundFloat(amount*currencyDecimals, GetDefaultRounding())), currency)
+}
+
+// NewFromFloatChecked creates and returns new instance of Money from a float64, rounded
+// to minor units with given rounding mode. The float is taken as its shortest decimal
+// representation, so 4.35 is 4.35 rather than 4.34999.... It returns ErrInvalidAmount
+// for NaN and infinities, ErrPrecisionLoss when floats around the amount are more than
+// half a minor unit apart and ErrOverflow when the amount doesn't fit into Amount.
+func NewFromFloatChecked(amount float64, currency string, mode RoundingMode) (*Money, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return nil, ErrInvalidAmount
+	}
+
+	m, err := NewWithOptions(0, currency)
+	if err != nil {
+		return nil, err
+	}
+	f := m.Currency.Fraction
+
+	abs := math.Abs(amount)
+	if (math.Nextafter(abs, math.Inf(1))-abs)*math.Pow10(f) > 0.5 {
+		return nil, ErrPrecisionLoss
+	}
+
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(amount, 'g', -1, 64))
+	q := roundQuo(new(big.Int).Mul(r.Num(), scale(f)), r.Denom(), mode)
+	if !q.IsInt64() {
+		return nil, ErrOverflow
+	}
+	m.Amount = Amount(q.Int64())
+
+	return m, nil
+}
+
+// Clone returns a deep copy of Money, including its Currency. Money returned by most
+// operations shares the Currency of its operand; Clone detaches the copy so its Currency
+// can be modified without affecting any other Money.
+func (m *Money) Clone() *Money {
+	if m == nil {
+		return nil
+	}
+
+	c := &Money{Amount: m.Amount}
+	if m.Currency != nil {
+		cc := *m.Currency
+		c.Currency = &cc
+	}
+
+	return c
+}
+
+// IsValid reports whether Money is non-nil and has a Currency with a code.
+func (m *Money) IsValid() bool {
+	return m != nil && m.Currency != nil && m.Currency.Code != ""
 }
 
 // SameCurrency check if given Money is equals by Currency.
+// Nil Money or Money without Currency never has the same Currency as other.
 func (m *Money) SameCurrency(om *Money) bool {
+	if m == nil || om == nil {
+		return false
+	}
+
 	return m.Currency.equals(om.Currency)
 }
 
 func (m *Money) assertSameCurrency(om *Money) error {
-	if !m.SameCurrency(om) {
-		return ErrCurrencyMismatch
+	var err error
+	switch {
+	case m == nil || om == nil:
+		err = ErrNilMoney
+	case m.Currency == nil || om.Currency == nil:
+		err = ErrNilCurrency
+	case !m.SameCurrency(om):
+		err = newCurrencyMismatch(m.Currency, om.Currency)
+	}
+
+	if err != nil && GetPolicy() == PolicyPanic {
+		panic(err)
 	}
 
-	return nil
+	return err
 }
 
 func (m *Money) compare(om *Money) int {
@@ -76,6 +282,38 @@ func (m *Money) Equals(om *Money) (bool, error) {
 	return m.compare(om) == 0, nil
 }
 
+// Equal reports whether Money has the same amount and Currency code as the other.
+// Unlike Equals it doesn't fail on different currencies, such Money is simply not equal.
+// Two nil Money are equal.
+func (m *Money) Equal(om *Money) bool {
+	if m == nil || om == nil {
+		return m == om
+	}
+
+	return m.Amount == om.Amount && m.SameCurrency(om)
+}
+
+// EqualValue reports whether Money is deeply equal to the other: unlike Equal it also
+// compares the details of the Currency, like its fraction digits and formatting, so
+// Money rescaled or customized with options isn't equal to Money of the registered
+// Currency. Currencies are compared by value rather than by pointer, so Money decoded
+// from JSON or cloned equals the original. Currency holding only its code is resolved
+// from the registry first. Two nil Money are equal.
+func (m *Money) EqualValue(om *Money) bool {
+	if m == nil || om == nil {
+		return m == om
+	}
+
+	if m.Currency == nil || om.Currency == nil {
+		return m.Amount == om.Amount && m.Currency == om.Currency
+	}
+
+	c, oc := *m.Currency.get(), *om.Currency.get()
+	c.Code, oc.Code = canonicalCode(c.Code), canonicalCode(oc.Code)
+
+	return m.Amount == om.Amount && c == oc
+}
+
 // GreaterThan checks whether the value of Money is greater than the other.
 func (m *Money) GreaterThan(om *Money) (bool, error) {
 	if err := m.assertSameCurrency(om); err != nil {
@@ -128,134 +366,289 @@ func (m *Money) IsNegative() bool {
 }
 
 // Absolute returns new Money struct from given Money using absolute monetary value.
+// The absolute value of MinAmount doesn't fit into Amount, it wraps around under
+// PolicyError, or panics or saturates depending on the active Policy.
 func (m *Money) Absolute() *Money {
-	return &Money{Amount: mutate.calc.absolute(m.Amount), Currency: m.Currency}
+	a, ok := calcAbsolute(m.Amount)
+	if !ok && GetPolicy() != PolicyError {
+		a, _ = overflowed(saturation(true))
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}
 }
 
 // Negative returns new Money struct from given Money using negative monetary value.
 func (m *Money) Negative() *Money {
-	return &Money{Amount: mutate.calc.negative(m.Amount), Currency: m.Currency}
+	return &Money{Amount: calcNegative(m.Amount), Currency: m.Currency}
 }
 
 // Add returns new Money struct with value representing sum of Self and Other Money.
+// When the sum doesn't fit into Amount it returns ErrOverflow, or panics or saturates
+// depending on the active Policy.
 func (m *Money) Add(om *Money) (*Money, error) {
 	if err := m.assertSameCurrency(om); err != nil {
 		return nil, err
 	}
 
-	return &Money{Amount: mutate.calc.add(m.Amount, om.Amount), Currency: m.Currency}, nil
+	a, ok := calcAdd(m.Amount, om.Amount)
+	if !ok {
+		var err error
+		if a, err = overflowed(saturation(om.Amount > 0)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}, nil
 }
 
 // Subtract returns new Money struct with value representing difference of Self and Other Money.
+// When the difference doesn't fit into Amount it returns ErrOverflow, or panics or saturates
+// depending on the active Policy.
 func (m *Money) Subtract(om *Money) (*Money, error) {
 	if err := m.assertSameCurrency(om); err != nil {
 		return nil, err
 	}
 
-	return &Money{Amount: mutate.calc.subtract(m.Amount, om.Amount), Currency: m.Currency}, nil
+	a, ok := calcSubtract(m.Amount, om.Amount)
+	if !ok {
+		var err error
+		if a, err = overflowed(saturation(om.Amount < 0)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}, nil
 }
 
 // Multiply returns new Money struct with value representing Self multiplied value by multiplier.
+// When the product doesn't fit into Amount it wraps around under PolicyError, which has no
+// way to report it, or panics or saturates depending on the active Policy.
 func (m *Money) Multiply(mul int64) *Money {
-	return &Money{Amount: mutate.calc.multiply(m.Amount, mul), Currency: m.Currency}
+	a, ok := calcMultiply(m.Amount, mul)
+	if !ok && GetPolicy() != PolicyError {
+		a, _ = overflowed(saturation((m.Amount < 0) == (mul < 0)))
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}
 }
 
 // Round returns new Money struct with value rounded to nearest zero.
+// Amounts next to the limits of Amount may round past them, they wrap around under
+// PolicyError, or panic or saturate depending on the active Policy.
+// Ties are rounded towards zero regardless of the default RoundingMode, use RoundWithMode
+// to choose.
 func (m *Money) Round() *Money {
-	return &Money{Amount: mutate.calc.round(m.Amount, m.Currency.Fraction), Currency: m.Currency}
+	a, ok := calcRound(m.Amount, m.Currency.Fraction, RoundHalfDown)
+	if !ok && GetPolicy() != PolicyError {
+		a, _ = overflowed(saturation(m.Amount > 0))
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}
+}
+
+// RoundWithMode returns new Money struct with value rounded to whole major units with
+// given rounding mode. When the result doesn't fit into Amount it returns ErrOverflow,
+// or panics or saturates depending on the active Policy.
+func (m *Money) RoundWithMode(mode RoundingMode) (*Money, error) {
+	a, ok := calcRound(m.Amount, m.Currency.Fraction, mode)
+	if !ok {
+		var err error
+		if a, err = overflowed(saturation(m.Amount > 0)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}, nil
 }
 
 // Split returns slice of Money structs with split Self value in given number.
 // After division leftover pennies will be distributed round-robin amongst the parties.
 // This means that parties listed first will likely receive more pennies than ones that are listed later.
 func (m *Money) Split(n int) ([]*Money, error) {
+	as, err := m.SplitAmounts(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parties(as), nil
+}
+
+// SplitAmounts works like Split but returns the amounts of the parties only.
+// It allocates a single slice regardless of the number of parties, which makes it
+// better suited for splits into a large number of parties.
+func (m *Money) SplitAmounts(n int) ([]Amount, error) {
 	if n <= 0 {
-		return nil, errors.New("split must be higher than zero")
+		return nil, fmt.Errorf("%w: split must be higher than zero", ErrInvalidRatio)
 	}
 
-	a := mutate.calc.divide(m.Amount, int64(n))
-	ms := make([]*Money, n)
+	a := calcDivide(m.Amount, int64(n))
+	as := make([]Amount, n)
 
 	for i := 0; i < n; i++ {
-		ms[i] = &Money{Amount: a, Currency: m.Currency}
+		as[i] = a
 	}
 
-	r := mutate.calc.modulus(m.Amount, int64(n))
+	r := calcModulus(m.Amount, int64(n))
 	l := mutate.calc.absolute(r)
 	// Add leftovers to the first parties.
 
-	v := int64(1)
+	v := Amount(1)
 	if m.Amount < 0 {
 		v = -1
 	}
 	for p := 0; l != 0; p++ {
-		ms[p].Amount = mutate.calc.add(ms[p].Amount, v)
+		as[p] = mutate.calc.add(as[p], v)
 		l--
 	}
 
-	return ms, nil
+	return as, nil
 }
 
 // Allocate returns slice of Money structs with split Self value in given ratios.
 // It lets split money by given ratios without losing pennies and as Split operations distributes
 // leftover pennies amongst the parties with round-robin principle.
 func (m *Money) Allocate(rs ...int) ([]*Money, error) {
+	as, err := m.AllocateAmounts(rs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parties(as), nil
+}
+
+// AllocateAmounts works like Allocate but returns the amounts of the parties only.
+// It allocates a single slice regardless of the number of parties, which makes it
+// better suited for allocations to a large number of parties.
+func (m *Money) AllocateAmounts(rs ...int) ([]Amount, error) {
+	sum, err := ratioSum(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	var total Amount
+	as := make([]Amount, len(rs))
+	for i, r := range rs {
+		as[i] = calcAllocate(m.Amount, uint64(r), uint64(sum))
+		total += as[i]
+	}
+
+	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
+	// with the leftover
+	if sum == 0 {
+		return as, nil
+	}
+
+	// Calculate leftover value and divide to first parties.
+	spreadLeftover(as, m.Amount-total)
+
+	return as, nil
+}
+
+// AllocateInt64 works like Allocate but takes int64 ratios, so large ratios like amounts
+// in minor units can be used as weights on all platforms. Intermediate results are
+// computed without overflow, it returns ErrOverflow when the ratios don't sum into int64.
+func (m *Money) AllocateInt64(rs ...int64) ([]*Money, error) {
 	if len(rs) == 0 {
-		return nil, errors.New("no ratios specified")
+		return nil, fmt.Errorf("%w: no ratios specified", ErrInvalidRatio)
 	}
 
-	// Calculate sum of ratios.
-	var sum uint
+	var sum int64
 	for _, r := range rs {
 		if r < 0 {
-			return nil, errors.New("negative ratios not allowed")
+			return nil, fmt.Errorf("%w: negative ratios not allowed", ErrInvalidRatio)
 		}
-		sum += uint(r)
-	}
 
-	var total int64
-	ms := make([]*Money, 0, len(rs))
-	for _, r := range rs {
-		party := &Money{
-			Amount:   mutate.calc.allocate(m.Amount, uint(r), sum),
-			Currency: m.Currency,
+		if sum > math.MaxInt64-r {
+			return nil, fmt.Errorf("%w: sum of ratios", ErrOverflow)
 		}
+		sum += r
+	}
 
-		ms = append(ms, party)
-		total += party.Amount
+	var total Amount
+	as := make([]Amount, len(rs))
+	for i, r := range rs {
+		as[i] = calcAllocate(m.Amount, uint64(r), uint64(sum))
+		total += as[i]
 	}
 
 	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
 	// with the leftover
-	if sum == 0 {
-		return ms, nil
+	if sum != 0 {
+		spreadLeftover(as, m.Amount-total)
 	}
 
-	// Calculate leftover value and divide to first parties.
-	lo := m.Amount - total
-	sub := int64(1)
+	return m.parties(as), nil
+}
+
+// spreadLeftover distributes leftover pennies one by one to the first parties.
+func spreadLeftover(as []Amount, lo Amount) {
+	sub := Amount(1)
 	if lo < 0 {
 		sub = -sub
 	}
 
 	for p := 0; lo != 0; p++ {
-		ms[p].Amount = mutate.calc.add(ms[p].Amount, sub)
+		as[p] = mutate.calc.add(as[p], sub)
 		lo -= sub
 	}
+}
+
+// parties returns Money of the Currency of Self for each of given amounts.
+// All parties share one backing array to keep the number of allocations constant.
+func (m *Money) parties(as []Amount) []*Money {
+	backing := make([]Money, len(as))
+	ms := make([]*Money, len(as))
+
+	// Each party gets its own copy of the Currency, so modifying one doesn't affect the
+	// others or m.
+	var cs []Currency
+	if m.Currency != nil {
+		cs = make([]Currency, len(as))
+	}
 
-	return ms, nil
+	for i, a := range as {
+		backing[i] = Money{Amount: a}
+		if cs != nil {
+			cs[i] = *m.Currency
+			backing[i].Currency = &cs[i]
+		}
+		ms[i] = &backing[i]
+	}
+
+	return ms
+}
+
+// ratioSum validates given allocation ratios and returns their sum.
+func ratioSum(rs []int) (uint, error) {
+	if len(rs) == 0 {
+		return 0, fmt.Errorf("%w: no ratios specified", ErrInvalidRatio)
+	}
+
+	var sum uint
+	for _, r := range rs {
+		if r < 0 {
+			return 0, fmt.Errorf("%w: negative ratios not allowed", ErrInvalidRatio)
+		}
+
+		if sum+uint(r) < sum {
+			return 0, fmt.Errorf("%w: sum of ratios", ErrOverflow)
+		}
+		sum += uint(r)
+	}
+
+	return sum, nil
 }
 
 // Display lets represent Money struct as string in given Currency value.
 func (m *Money) Display() string {
 	c := m.Currency.get()
-	return c.Formatter().Format(m.Amount)
+	return c.Formatter().Format(int64(m.Amount))
 }
 
 // AsMajorUnits lets represent Money struct as subunits (float64) in given Currency value
 func (m *Money) AsMajorUnits() float64 {
 	c := m.Currency.get()
-	return c.Formatter().ToMajorUnits(m.Amount)
+	return c.Formatter().ToMajorUnits(int64(m.Amount))
 }
 
 // Compare function compares two money of the same type
@@ -265,8 +658,12 @@ func (m *Money) AsMajorUnits() float64 {
 //	if m.Amount < om.Amount returns (-1, nil)
 //
 // If compare moneys from distinct Currency, return (m.Amount, ErrCurrencyMismatch)
+// If compare nil Money, return (0, ErrNilMoney)
 func (m *Money) Compare(om *Money) (int, error) {
 	if err := m.assertSameCurrency(om); err != nil {
+		if m == nil {
+			return 0, err
+		}
 		return int(m.Amount), err
 	}
 
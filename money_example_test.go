@@ -57,7 +57,7 @@ func ExampleMoney_comparisons() {
 	// Output:
 	// false <nil>
 	// true <nil>
-	// false currencies don't match
+	// false currencies don't match: GBP vs EUR
 }
 
 func ExampleMoney_IsZero() {
@@ -178,3 +178,11 @@ func ExampleMoney_AsMajorUnits() {
 	// Output:
 	// 1234567.89
 }
+
+func ExampleSum() {
+	total, err := money.Sum(money.New(100, "GBP"), money.New(250, "GBP"), money.New(-50, "GBP"))
+	fmt.Println(total.Display(), err)
+
+	// Output:
+	// £3.00 <nil>
+}
@@ -1,7 +1,9 @@
 package money
 
 import (
+	"encoding/json"
 	"errors"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -24,6 +26,65 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewChecked(t *testing.T) {
+	m, err := NewChecked(100, "usd")
+	if err != nil || m.Amount != 100 || *m.Currency != *GetCurrency(USD) {
+		t.Errorf("Expected %d %s got %v (%v)", 100, USD, m, err)
+	}
+
+	for _, code := range []string{"USS", "", "US D"} {
+		var uerr *UnsupportedCurrencyError
+		if _, err := NewChecked(100, code); !errors.As(err, &uerr) || uerr.Code != code {
+			t.Errorf("Expected %v for %q got %v", ErrUnsupportedCurrency, code, err)
+		}
+	}
+}
+
+func TestNewStrict(t *testing.T) {
+	m, err := NewStrict(100, " usd ")
+	if err != nil || m.Amount != 100 || *m.Currency != *GetCurrency(USD) {
+		t.Errorf("Expected %d %s got %v (%v)", 100, USD, m, err)
+	}
+
+	for _, code := range []string{"", "US", "USDT", "US1", "U D", "ÜSD"} {
+		if _, err := NewStrict(100, code); !errors.Is(err, ErrInvalidCurrency) {
+			t.Errorf("Expected %v for %q got %v", ErrInvalidCurrency, code, err)
+		}
+	}
+
+	if _, err := NewStrict(100, "USS"); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+}
+
+func TestAmount(t *testing.T) {
+	tcs := []struct {
+		a, o Amount
+		abs  Amount
+		cmp  int
+		str  string
+	}{
+		{-1234, 0, 1234, -1, "-1234"},
+		{1234, 1234, 1234, 0, "1234"},
+		{0, -1, 0, 1, "0"},
+		{MinAmount, MaxAmount, MinAmount, -1, "-9223372036854775808"},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.a.Abs(); r != tc.abs {
+			t.Errorf("Expected absolute of %d to be %d got %d", tc.a, tc.abs, r)
+		}
+
+		if r := tc.a.Cmp(tc.o); r != tc.cmp {
+			t.Errorf("Expected %d compared to %d to be %d got %d", tc.a, tc.o, tc.cmp, r)
+		}
+
+		if r := tc.a.String(); r != tc.str {
+			t.Errorf("Expected %s got %s", tc.str, r)
+		}
+	}
+}
+
 func TestCurrency(t *testing.T) {
 	code := "MOCK"
 	decimals := 5
@@ -83,9 +144,14 @@ func TestMoney_Equals_DifferentCurrencies(t *testing.T) {
 	usd := New(0, USD)
 
 	_, err := eur.Equals(usd)
-	if err == nil || !errors.Is(ErrCurrencyMismatch, err) {
+	if err == nil || !errors.Is(err, ErrCurrencyMismatch) {
 		t.Errorf("Expected Equals to return %q, got %v", ErrCurrencyMismatch.Error(), err)
 	}
+
+	var merr *CurrencyMismatchError
+	if !errors.As(err, &merr) || merr.A != EUR || merr.B != USD {
+		t.Errorf("Expected mismatch of %s and %s, got %v", EUR, USD, err)
+	}
 }
 
 func TestMoney_GreaterThan(t *testing.T) {
@@ -241,7 +307,7 @@ func TestMoney_IsPositive(t *testing.T) {
 func TestMoney_Absolute(t *testing.T) {
 	tcs := []struct {
 		amount   int64
-		expected int64
+		expected Amount
 	}{
 		{-1, 1},
 		{0, 0},
@@ -262,7 +328,7 @@ func TestMoney_Absolute(t *testing.T) {
 func TestMoney_Negative(t *testing.T) {
 	tcs := []struct {
 		amount   int64
-		expected int64
+		expected Amount
 	}{
 		{-1, -1},
 		{0, -0},
@@ -284,7 +350,7 @@ func TestMoney_Add(t *testing.T) {
 	tcs := []struct {
 		amount1  int64
 		amount2  int64
-		expected int64
+		expected Amount
 	}{
 		{5, 5, 10},
 		{10, 5, 15},
@@ -321,7 +387,7 @@ func TestMoney_Subtract(t *testing.T) {
 	tcs := []struct {
 		amount1  int64
 		amount2  int64
-		expected int64
+		expected Amount
 	}{
 		{5, 5, 0},
 		{10, 5, 5},
@@ -358,7 +424,7 @@ func TestMoney_Multiply(t *testing.T) {
 	tcs := []struct {
 		amount     int64
 		multiplier int64
-		expected   int64
+		expected   Amount
 	}{
 		{5, 5, 25},
 		{10, 5, 50},
@@ -379,7 +445,7 @@ func TestMoney_Multiply(t *testing.T) {
 func TestMoney_Round(t *testing.T) {
 	tcs := []struct {
 		amount   int64
-		expected int64
+		expected Amount
 	}{
 		{125, 100},
 		{175, 200},
@@ -400,10 +466,38 @@ func TestMoney_Round(t *testing.T) {
 	}
 }
 
+func TestMoney_RoundWithMode(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		mode     RoundingMode
+		expected Amount
+	}{
+		{150, RoundHalfUp, 200},
+		{150, RoundHalfDown, 100},
+		{250, RoundHalfEven, 200},
+		{350, RoundHalfEven, 400},
+		{101, RoundUp, 200},
+		{-101, RoundCeiling, -100},
+		{-101, RoundFloor, -200},
+		{199, RoundDown, 100},
+		{-200, RoundUp, -200},
+	}
+
+	for _, tc := range tcs {
+		if r, err := New(tc.amount, EUR).RoundWithMode(tc.mode); err != nil || r.Amount != tc.expected {
+			t.Errorf("Expected %d rounded %s to be %d got %v (%v)", tc.amount, tc.mode, tc.expected, r, err)
+		}
+	}
+
+	if _, err := New(int64(MaxAmount), EUR).RoundWithMode(RoundUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
 func TestMoney_RoundWithExponential(t *testing.T) {
 	tcs := []struct {
 		amount   int64
-		expected int64
+		expected Amount
 	}{
 		{12555, 13000},
 	}
@@ -423,19 +517,19 @@ func TestMoney_Split(t *testing.T) {
 	tcs := []struct {
 		amount   int64
 		split    int
-		expected []int64
+		expected []Amount
 	}{
-		{100, 3, []int64{34, 33, 33}},
-		{100, 4, []int64{25, 25, 25, 25}},
-		{5, 3, []int64{2, 2, 1}},
-		{-101, 4, []int64{-26, -25, -25, -25}},
-		{-101, 4, []int64{-26, -25, -25, -25}},
-		{-2, 3, []int64{-1, -1, 0}},
+		{100, 3, []Amount{34, 33, 33}},
+		{100, 4, []Amount{25, 25, 25, 25}},
+		{5, 3, []Amount{2, 2, 1}},
+		{-101, 4, []Amount{-26, -25, -25, -25}},
+		{-101, 4, []Amount{-26, -25, -25, -25}},
+		{-2, 3, []Amount{-1, -1, 0}},
 	}
 
 	for _, tc := range tcs {
 		m := New(tc.amount, EUR)
-		var rs []int64
+		var rs []Amount
 		split, _ := m.Split(tc.split)
 
 		for _, party := range split {
@@ -445,6 +539,14 @@ func TestMoney_Split(t *testing.T) {
 		if !reflect.DeepEqual(tc.expected, rs) {
 			t.Errorf("Expected split of %d to be %v got %v", tc.amount, tc.expected, rs)
 		}
+
+		if as, _ := m.SplitAmounts(tc.split); !reflect.DeepEqual(tc.expected, as) {
+			t.Errorf("Expected split amounts of %d to be %v got %v", tc.amount, tc.expected, as)
+		}
+
+		if _, err := VerifyAllocation(m, split, nil, nil); err != nil {
+			t.Errorf("Expected split of %d to be a valid allocation got %v", tc.amount, err)
+		}
 	}
 }
 
@@ -461,21 +563,21 @@ func TestMoney_Allocate(t *testing.T) {
 	tcs := []struct {
 		amount   int64
 		ratios   []int
-		expected []int64
+		expected []Amount
 	}{
-		{100, []int{50, 50}, []int64{50, 50}},
-		{100, []int{30, 30, 30}, []int64{34, 33, 33}},
-		{200, []int{25, 25, 50}, []int64{50, 50, 100}},
-		{5, []int{50, 25, 25}, []int64{3, 1, 1}},
-		{0, []int{0, 0, 0, 0}, []int64{0, 0, 0, 0}},
-		{0, []int{50, 10}, []int64{0, 0}},
-		{10, []int{0, 100}, []int64{0, 10}},
-		{10, []int{0, 0}, []int64{0, 0}},
+		{100, []int{50, 50}, []Amount{50, 50}},
+		{100, []int{30, 30, 30}, []Amount{34, 33, 33}},
+		{200, []int{25, 25, 50}, []Amount{50, 50, 100}},
+		{5, []int{50, 25, 25}, []Amount{3, 1, 1}},
+		{0, []int{0, 0, 0, 0}, []Amount{0, 0, 0, 0}},
+		{0, []int{50, 10}, []Amount{0, 0}},
+		{10, []int{0, 100}, []Amount{0, 10}},
+		{10, []int{0, 0}, []Amount{0, 0}},
 	}
 
 	for _, tc := range tcs {
 		m := New(tc.amount, EUR)
-		var rs []int64
+		var rs []Amount
 		split, _ := m.Allocate(tc.ratios...)
 
 		for _, party := range split {
@@ -486,6 +588,11 @@ func TestMoney_Allocate(t *testing.T) {
 			t.Errorf("Expected allocation of %d for ratios %v to be %v got %v", tc.amount, tc.ratios,
 				tc.expected, rs)
 		}
+
+		if as, _ := m.AllocateAmounts(tc.ratios...); !reflect.DeepEqual(tc.expected, as) {
+			t.Errorf("Expected allocation amounts of %d for ratios %v to be %v got %v", tc.amount, tc.ratios,
+				tc.expected, as)
+		}
 	}
 }
 
@@ -623,7 +730,7 @@ func TestMoney_Comparison(t *testing.T) {
 			twoPounds.Amount, -1, r)
 	}
 
-	if _, err := pound.Compare(twoEuros); err != ErrCurrencyMismatch {
+	if _, err := pound.Compare(twoEuros); !errors.Is(err, ErrCurrencyMismatch) {
 		t.Error("Expected err")
 	}
 
@@ -674,3 +781,278 @@ func TestNewFromFloat(t *testing.T) {
 		t.Errorf("Expected %d got %d", -19914, m.Amount)
 	}
 }
+
+func TestNewFromFloatChecked(t *testing.T) {
+	tcs := []struct {
+		amount   float64
+		code     string
+		mode     RoundingMode
+		expected Amount
+		err      error
+	}{
+		{4.35, EUR, RoundDown, 435, nil},
+		{4.35, EUR, RoundHalfUp, 435, nil},
+		{1.005, EUR, RoundHalfUp, 101, nil},
+		{1.005, EUR, RoundHalfEven, 100, nil},
+		{-12.345, EUR, RoundFloor, -1235, nil},
+		{12.5, JPY, RoundHalfEven, 12, nil},
+		{1e-9, EUR, RoundUp, 1, nil},
+		{1e17, EUR, RoundHalfUp, 0, ErrPrecisionLoss},
+		{9e18, JPY, RoundHalfUp, 0, ErrPrecisionLoss},
+		{math.NaN(), EUR, RoundHalfUp, 0, ErrInvalidAmount},
+		{math.Inf(-1), EUR, RoundHalfUp, 0, ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromFloatChecked(tc.amount, tc.code, tc.mode)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %v got %v", tc.err, tc.amount, err)
+			continue
+		}
+
+		if err == nil && (m.Amount != tc.expected || m.Currency.Code != tc.code) {
+			t.Errorf("Expected %d %s for %v with %s got %d %s", tc.expected, tc.code, tc.amount, tc.mode,
+				m.Amount, m.Currency.Code)
+		}
+	}
+}
+
+func TestMoney_Equal(t *testing.T) {
+	tcs := []struct {
+		m        *Money
+		om       *Money
+		expected bool
+	}{
+		{New(100, EUR), New(100, EUR), true},
+		{New(100, EUR), &Money{Amount: 100, Currency: &Currency{Code: EUR}}, true},
+		{New(100, EUR), New(101, EUR), false},
+		{New(100, EUR), New(100, USD), false},
+		{New(100, EUR), nil, false},
+		{nil, nil, true},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.m.Equal(tc.om); r != tc.expected {
+			t.Errorf("Expected %v Equal %v == %t got %t", tc.m, tc.om, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_EqualValue(t *testing.T) {
+	m := New(100, EUR)
+
+	var decoded Money
+	b, _ := json.Marshal(m)
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	rescaled, _ := m.Rescale(3, RoundHalfUp)
+	custom := New(100, EUR, WithFormatter(NewFormatter(2, ",", ".", "€", "1 $")))
+
+	tcs := []struct {
+		m        *Money
+		om       *Money
+		expected bool
+	}{
+		{m, New(100, EUR), true},
+		{m, &decoded, true},
+		{m, m.Clone(), true},
+		{m, &Money{Amount: 100, Currency: &Currency{Code: "eur"}}, true},
+		{m, New(101, EUR), false},
+		{m, New(100, USD), false},
+		{New(1000, EUR), rescaled, false},
+		{m, custom, false},
+		{&Money{Amount: 1}, &Money{Amount: 1}, true},
+		{&Money{Amount: 1}, New(1, EUR), false},
+		{m, nil, false},
+		{nil, nil, true},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.m.EqualValue(tc.om); r != tc.expected {
+			t.Errorf("Expected %#v EqualValue %#v == %t got %t", tc.m, tc.om, tc.expected, r)
+		}
+
+		if r := tc.om.EqualValue(tc.m); r != tc.expected {
+			t.Errorf("Expected EqualValue to be symmetric for %#v and %#v", tc.m, tc.om)
+		}
+	}
+}
+
+var benchResult *Money
+
+func BenchmarkMoney_Add(b *testing.B) {
+	m, om := New(100, EUR), New(1, EUR)
+	for i := 0; i < b.N; i++ {
+		benchResult, _ = m.Add(om)
+	}
+}
+
+func BenchmarkMoney_Subtract(b *testing.B) {
+	m, om := New(100, EUR), New(1, EUR)
+	for i := 0; i < b.N; i++ {
+		benchResult, _ = m.Subtract(om)
+	}
+}
+
+func BenchmarkMoney_Multiply(b *testing.B) {
+	m := New(100, EUR)
+	for i := 0; i < b.N; i++ {
+		benchResult = m.Multiply(3)
+	}
+}
+
+func BenchmarkMoney_Allocate(b *testing.B) {
+	m := New(100, EUR)
+	for i := 0; i < b.N; i++ {
+		ms, _ := m.Allocate(33, 33, 33)
+		benchResult = ms[0]
+	}
+}
+
+func BenchmarkMoney_AllocateAmounts(b *testing.B) {
+	m := New(100000000, EUR)
+	rs := make([]int, 100000)
+	for i := range rs {
+		rs[i] = i%7 + 1
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.AllocateAmounts(rs...)
+	}
+}
+
+func TestMoney_IsValid(t *testing.T) {
+	tcs := []struct {
+		m        *Money
+		expected bool
+	}{
+		{New(100, EUR), true},
+		{&Money{Amount: 100}, false},
+		{&Money{Currency: &Currency{}}, false},
+		{nil, false},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.m.IsValid(); r != tc.expected {
+			t.Errorf("Expected %v IsValid == %t got %t", tc.m, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_NilSafety(t *testing.T) {
+	var zero Money
+
+	tcs := []struct {
+		m        *Money
+		om       *Money
+		expected error
+	}{
+		{New(100, EUR), nil, ErrNilMoney},
+		{nil, New(100, EUR), ErrNilMoney},
+		{New(100, EUR), &zero, ErrNilCurrency},
+		{&zero, &zero, ErrNilCurrency},
+	}
+
+	for _, tc := range tcs {
+		if _, err := tc.m.Add(tc.om); !errors.Is(err, tc.expected) {
+			t.Errorf("Expected %v got %v", tc.expected, err)
+		}
+
+		if _, err := tc.m.Compare(tc.om); !errors.Is(err, tc.expected) {
+			t.Errorf("Expected %v got %v", tc.expected, err)
+		}
+
+		if tc.m.SameCurrency(tc.om) {
+			t.Errorf("Expected %v and %v not to have the same currency", tc.m, tc.om)
+		}
+	}
+
+	if r := zero.Display(); r != "0.00" {
+		t.Errorf("Expected %s got %s", "0.00", r)
+	}
+}
+
+func TestMoney_Clone(t *testing.T) {
+	m := New(1099, EUR)
+	c := m.Clone()
+
+	if c == m || c.Currency == m.Currency || !c.Equal(m) {
+		t.Errorf("Expected a detached copy of %v got %v", m, c)
+	}
+
+	c.Amount = 1
+	c.Currency.Fraction = 3
+	if m.Amount != 1099 || m.Currency.Fraction != 2 || GetCurrency(EUR).Fraction != 2 {
+		t.Errorf("Expected original to be unchanged got %v", m)
+	}
+
+	if (*Money)(nil).Clone() != nil || (&Money{Amount: 1}).Clone().Currency != nil {
+		t.Error("Expected nil Money and Currency to be kept")
+	}
+}
+
+func TestMoney_DetachedCurrency(t *testing.T) {
+	m := New(1099, EUR)
+	m.Currency.Fraction = 4
+	GetCurrency(EUR).Grapheme = "E"
+
+	if c := GetCurrency(EUR); c.Fraction != 2 || c.Grapheme != "€" || New(1, EUR).Currency.Fraction != 2 {
+		t.Errorf("Expected modifications not to reach the registry got %+v", c)
+	}
+
+	ps, _ := New(1000, EUR).Split(3)
+	ps[0].Currency.Fraction = 4
+	if ps[1].Currency.Fraction != 2 || ps[0].Currency == ps[1].Currency {
+		t.Errorf("Expected each party to have its own Currency got %+v", ps[1].Currency)
+	}
+
+	if ps, _ := (&Money{Amount: 10}).Allocate(1, 1); ps[0].Currency != nil {
+		t.Errorf("Expected parties without Currency got %+v", ps[0].Currency)
+	}
+}
+
+func TestMoney_AllocateInt64(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		ratios   []int64
+		expected []int64
+	}{
+		{100, []int64{30, 30, 30}, []int64{34, 33, 33}},
+		{-5, []int64{50, 25, 25}, []int64{-3, -1, -1}},
+		{10, []int64{0, 0}, []int64{0, 0}},
+		{math.MaxInt64, []int64{1, 1}, []int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2}},
+		{1000000000000, []int64{math.MaxInt64 / 2, math.MaxInt64 / 2}, []int64{500000000000, 500000000000}},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		parts, err := m.AllocateInt64(tc.ratios...)
+		if err != nil || !reflect.DeepEqual(amounts(parts), tc.expected) {
+			t.Errorf("Expected allocation of %d for ratios %v to be %v got %v (%v)", tc.amount, tc.ratios,
+				tc.expected, amounts(parts), err)
+		}
+	}
+
+	if _, err := New(1, EUR).AllocateInt64(math.MaxInt64, 1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := New(1, EUR).AllocateInt64(1, -1); !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Expected %v got %v", ErrInvalidRatio, err)
+	}
+}
+
+func TestMoney_AllocateLargeAmount(t *testing.T) {
+	parts, err := New(math.MaxInt64, EUR).Allocate(3, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := parts[0].Amount + parts[1].Amount
+	if sum != math.MaxInt64 || parts[0].Amount != 2767011611056432743 {
+		t.Errorf("Expected parts summing to %d got %v", int64(math.MaxInt64), amounts(parts))
+	}
+}
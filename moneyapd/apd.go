@@ -0,0 +1,46 @@
+// Package moneyapd converts between money.Money and cockroachdb/apd decimals.
+package moneyapd
+
+import (
+	"github.com/cockroachdb/apd"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// FromDecimal creates and returns new instance of Money from a decimal value in major units.
+// The value is quantized to the Currency fraction using ctx, so its Precision and Rounding
+// decide how extra digits are handled. The returned Condition reports whether rounding
+// happened; set apd.Inexact in ctx.Traps to turn any rounding into an error instead.
+func FromDecimal(ctx *apd.Context, d *apd.Decimal, code string) (*money.Money, apd.Condition, error) {
+	if d.Form != apd.Finite {
+		return nil, 0, money.ErrInvalidAmount
+	}
+
+	m, err := money.NewWithOptions(0, code)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var q apd.Decimal
+	res, err := ctx.Quantize(&q, d, -int32(m.Currency.Fraction))
+	if err != nil {
+		return nil, res, err
+	}
+
+	if !q.Coeff.IsInt64() {
+		return nil, res, money.ErrOverflow
+	}
+
+	m.Amount = money.Amount(q.Coeff.Int64())
+	if q.Negative {
+		m.Amount = -m.Amount
+	}
+
+	return m, res, nil
+}
+
+// ToDecimal returns the value of Money in major units as a decimal.
+// The conversion is exact.
+func ToDecimal(m *money.Money) *apd.Decimal {
+	return apd.New(int64(m.Amount), -int32(m.Currency.Fraction))
+}
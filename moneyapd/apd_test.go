@@ -0,0 +1,84 @@
+package moneyapd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cockroachdb/apd"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func TestFromDecimal(t *testing.T) {
+	tcs := []struct {
+		value    string
+		rounding string
+		expected money.Amount
+		inexact  bool
+	}{
+		{"10.99", apd.RoundHalfUp, 1099, false},
+		{"10.995", apd.RoundHalfUp, 1100, true},
+		{"10.995", apd.RoundHalfEven, 1100, true},
+		{"10.985", apd.RoundHalfEven, 1098, true},
+		{"10.999", apd.RoundDown, 1099, true},
+		{"-10.991", apd.RoundFloor, -1100, true},
+		{"-0.01", apd.RoundHalfUp, -1, false},
+	}
+
+	for _, tc := range tcs {
+		ctx := apd.BaseContext.WithPrecision(34)
+		ctx.Rounding = tc.rounding
+
+		d, _, _ := apd.NewFromString(tc.value)
+		m, res, err := FromDecimal(ctx, d, money.USD)
+
+		if err != nil || m.Amount != tc.expected || res.Inexact() != tc.inexact {
+			t.Errorf("Expected %s rounded %s to be %d (inexact %t) got %v (inexact %t, %v)",
+				tc.value, tc.rounding, tc.expected, tc.inexact, m, res.Inexact(), err)
+		}
+	}
+}
+
+func TestFromDecimal_Strict(t *testing.T) {
+	ctx := apd.BaseContext.WithPrecision(34)
+	ctx.Traps |= apd.Inexact
+
+	d, _, _ := apd.NewFromString("1.005")
+	if _, _, err := FromDecimal(ctx, d, money.EUR); err == nil {
+		t.Error("Expected err")
+	}
+
+	d, _, _ = apd.NewFromString("1E+20")
+	if _, _, err := FromDecimal(ctx, d, money.EUR); !errors.Is(err, money.ErrOverflow) {
+		t.Errorf("Expected %v got %v", money.ErrOverflow, err)
+	}
+}
+
+func TestFromDecimal_UnknownCode(t *testing.T) {
+	money.SetUnknownCodePolicy(money.UnknownCodeError())
+	defer money.SetUnknownCodePolicy(nil)
+
+	ctx := apd.BaseContext.WithPrecision(20)
+	if _, _, err := FromDecimal(ctx, apd.New(100, -2), "UNK"); !errors.Is(err, money.ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", money.ErrUnsupportedCurrency, err)
+	}
+}
+
+func TestToDecimal(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{1099, money.USD, "10.99"},
+		{-1, money.EUR, "-0.01"},
+		{1500, money.JPY, "1500"},
+		{1234, money.KWD, "1.234"},
+	}
+
+	for _, tc := range tcs {
+		if d := ToDecimal(money.New(tc.amount, tc.code)); d.String() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, d)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/seth-duckinga/go-money/moneyapd
+
+go 1.23
+
+require (
+	github.com/cockroachdb/apd v1.1.0
+	github.com/seth-duckinga/go-money v0.0.0
+)
+
+require github.com/pkg/errors v0.9.1 // indirect
+
+replace github.com/seth-duckinga/go-money => ../
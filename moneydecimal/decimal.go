@@ -0,0 +1,37 @@
+// Package moneydecimal converts between money.Money and shopspring/decimal values.
+package moneydecimal
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// FromDecimal creates and returns new instance of Money from a decimal value in major units.
+// It never rounds: money.ErrPrecisionLoss is returned when d has more fraction digits than
+// the Currency allows, and money.ErrOverflow when the amount doesn't fit into money.Amount.
+func FromDecimal(d decimal.Decimal, code string) (*money.Money, error) {
+	m, err := money.NewWithOptions(0, code)
+	if err != nil {
+		return nil, err
+	}
+
+	minor := d.Shift(int32(m.Currency.Fraction))
+	if !minor.IsInteger() {
+		return nil, money.ErrPrecisionLoss
+	}
+
+	a := minor.BigInt()
+	if !a.IsInt64() {
+		return nil, money.ErrOverflow
+	}
+	m.Amount = money.Amount(a.Int64())
+
+	return m, nil
+}
+
+// ToDecimal returns the value of Money in major units as a decimal.
+// The conversion is exact.
+func ToDecimal(m *money.Money) decimal.Decimal {
+	return decimal.New(int64(m.Amount), -int32(m.Currency.Fraction))
+}
@@ -0,0 +1,75 @@
+package moneydecimal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func TestFromDecimal(t *testing.T) {
+	tcs := []struct {
+		value    string
+		code     string
+		expected money.Amount
+		err      error
+	}{
+		{"10.99", money.USD, 1099, nil},
+		{"-0.01", money.EUR, -1, nil},
+		{"10.50", money.USD, 1050, nil},
+		{"1500", money.JPY, 1500, nil},
+		{"1.2345", money.KWD, 0, money.ErrPrecisionLoss},
+		{"0.005", money.USD, 0, money.ErrPrecisionLoss},
+		{"92233720368547758.08", money.USD, 0, money.ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		m, err := FromDecimal(decimal.RequireFromString(tc.value), tc.code)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %s got %v", tc.err, tc.value, err)
+			continue
+		}
+
+		if err == nil && (m.Amount != tc.expected || m.Currency.Code != tc.code) {
+			t.Errorf("Expected %d %s got %d %s", tc.expected, tc.code, m.Amount, m.Currency.Code)
+		}
+	}
+}
+
+func TestFromDecimal_UnknownCode(t *testing.T) {
+	money.SetUnknownCodePolicy(money.UnknownCodeError())
+	defer money.SetUnknownCodePolicy(nil)
+
+	if _, err := FromDecimal(decimal.RequireFromString("1.00"), "UNK"); !errors.Is(err, money.ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", money.ErrUnsupportedCurrency, err)
+	}
+}
+
+func TestToDecimal(t *testing.T) {
+	tcs := []struct {
+		amount   money.Amount
+		code     string
+		expected string
+	}{
+		{1099, money.USD, "10.99"},
+		{-1, money.EUR, "-0.01"},
+		{1500, money.JPY, "1500"},
+		{1234, money.KWD, "1.234"},
+	}
+
+	for _, tc := range tcs {
+		d := ToDecimal(money.New(int64(tc.amount), tc.code))
+
+		if !d.Equal(decimal.RequireFromString(tc.expected)) {
+			t.Errorf("Expected %s got %s", tc.expected, d)
+		}
+
+		m, err := FromDecimal(d, tc.code)
+		if err != nil || m.Amount != tc.amount {
+			t.Errorf("Expected round trip of %d got %v (%v)", tc.amount, m, err)
+		}
+	}
+}
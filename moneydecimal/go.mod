@@ -0,0 +1,10 @@
+module github.com/seth-duckinga/go-money/moneydecimal
+
+go 1.23
+
+require (
+	github.com/seth-duckinga/go-money v0.0.0
+	github.com/shopspring/decimal v1.4.0
+)
+
+replace github.com/seth-duckinga/go-money => ../
@@ -0,0 +1,164 @@
+package moneygeneric
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// Int64 is a 64-bit amount in minor units, the same range as money.Amount.
+type Int64 int64
+
+// Add returns the sum of a and b or money.ErrOverflow.
+func (a Int64) Add(b Int64) (Int64, error) {
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return 0, money.ErrOverflow
+	}
+
+	return a + b, nil
+}
+
+// Sub returns the difference of a and b or money.ErrOverflow.
+func (a Int64) Sub(b Int64) (Int64, error) {
+	if (b < 0 && a > math.MaxInt64+b) || (b > 0 && a < math.MinInt64+b) {
+		return 0, money.ErrOverflow
+	}
+
+	return a - b, nil
+}
+
+// Cmp compares a with b and returns -1, 0 or +1 when it's lower, equal or higher.
+func (a Int64) Cmp(b Int64) int {
+	return money.Amount(a).Cmp(money.Amount(b))
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of a.
+func (a Int64) Sign() int {
+	return a.Cmp(0)
+}
+
+func (a Int64) String() string {
+	return strconv.FormatInt(int64(a), 10)
+}
+
+// Int128 is a 128-bit amount in minor units in two's complement, e.g. for crypto
+// currencies whose smallest units overflow int64.
+type Int128 struct {
+	hi int64
+	lo uint64
+}
+
+// NewInt128 creates and returns new Int128 of given high and low 64 bits.
+func NewInt128(hi int64, lo uint64) Int128 {
+	return Int128{hi: hi, lo: lo}
+}
+
+// Int128From64 creates and returns new Int128 holding v.
+func Int128From64(v int64) Int128 {
+	return Int128{hi: v >> 63, lo: uint64(v)}
+}
+
+// Add returns the sum of a and b or money.ErrOverflow.
+func (a Int128) Add(b Int128) (Int128, error) {
+	lo, c := bits.Add64(a.lo, b.lo, 0)
+	hi, _ := bits.Add64(uint64(a.hi), uint64(b.hi), c)
+
+	// Overflow happens only when both operands have the same sign the result lacks.
+	if (a.hi < 0) == (b.hi < 0) && (int64(hi) < 0) != (a.hi < 0) {
+		return Int128{}, money.ErrOverflow
+	}
+
+	return Int128{hi: int64(hi), lo: lo}, nil
+}
+
+// Sub returns the difference of a and b or money.ErrOverflow.
+func (a Int128) Sub(b Int128) (Int128, error) {
+	lo, c := bits.Sub64(a.lo, b.lo, 0)
+	hi, _ := bits.Sub64(uint64(a.hi), uint64(b.hi), c)
+
+	// Overflow happens only when operands of different signs give a result of the sign of b.
+	if (a.hi < 0) != (b.hi < 0) && (int64(hi) < 0) != (a.hi < 0) {
+		return Int128{}, money.ErrOverflow
+	}
+
+	return Int128{hi: int64(hi), lo: lo}, nil
+}
+
+// Cmp compares a with b and returns -1, 0 or +1 when it's lower, equal or higher.
+func (a Int128) Cmp(b Int128) int {
+	switch {
+	case a.hi < b.hi || (a.hi == b.hi && a.lo < b.lo):
+		return -1
+	case a.hi > b.hi || (a.hi == b.hi && a.lo > b.lo):
+		return 1
+	}
+
+	return 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of a.
+func (a Int128) Sign() int {
+	return a.Cmp(Int128{})
+}
+
+// Big returns the value of a as big.Int.
+func (a Int128) Big() *big.Int {
+	b := big.NewInt(a.hi)
+	b.Lsh(b, 64)
+	return b.Add(b, new(big.Int).SetUint64(a.lo))
+}
+
+func (a Int128) String() string {
+	return a.Big().String()
+}
+
+// Big is an arbitrary precision amount in minor units. The zero value is zero.
+// Operations never modify the big.Int of their operands.
+type Big struct {
+	v *big.Int
+}
+
+// NewBig creates and returns new Big holding a copy of v.
+func NewBig(v *big.Int) Big {
+	return Big{v: new(big.Int).Set(v)}
+}
+
+func (a Big) int() *big.Int {
+	if a.v == nil {
+		return new(big.Int)
+	}
+
+	return a.v
+}
+
+// Add returns the sum of a and b, it never fails.
+func (a Big) Add(b Big) (Big, error) {
+	return Big{v: new(big.Int).Add(a.int(), b.int())}, nil
+}
+
+// Sub returns the difference of a and b, it never fails.
+func (a Big) Sub(b Big) (Big, error) {
+	return Big{v: new(big.Int).Sub(a.int(), b.int())}, nil
+}
+
+// Cmp compares a with b and returns -1, 0 or +1 when it's lower, equal or higher.
+func (a Big) Cmp(b Big) int {
+	return a.int().Cmp(b.int())
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of a.
+func (a Big) Sign() int {
+	return a.int().Sign()
+}
+
+// Int returns a copy of the value of a.
+func (a Big) Int() *big.Int {
+	return new(big.Int).Set(a.int())
+}
+
+func (a Big) String() string {
+	return a.int().String()
+}
@@ -0,0 +1,99 @@
+package moneygeneric
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// BigMoney is Money of arbitrary precision, for amounts that don't fit into money.Amount
+// like 18 decimal crypto assets or hyperinflation currencies. Currencies not known to the
+// money package, e.g. ETH, have to be registered with money.RegisterCurrency first.
+type BigMoney = Money[Big]
+
+// FromMoneyBig converts money.Money into BigMoney, which is always exact.
+func FromMoneyBig(m *money.Money) *BigMoney {
+	return &BigMoney{Amount: NewBig(big.NewInt(int64(m.Amount))), Currency: m.Currency}
+}
+
+// ToMoneyBig converts BigMoney into money.Money. It returns money.ErrOverflow when the
+// amount doesn't fit into money.Amount.
+func ToMoneyBig(m *BigMoney) (*money.Money, error) {
+	a := m.Amount.int()
+	if !a.IsInt64() {
+		return nil, money.ErrOverflow
+	}
+
+	return &money.Money{Amount: money.Amount(a.Int64()), Currency: m.Currency}, nil
+}
+
+// SplitBig works like money.Money.Split for BigMoney: leftover minor units are
+// distributed one by one to the first parties.
+func SplitBig(m *BigMoney, n int) ([]*BigMoney, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: split must be higher than zero", money.ErrInvalidRatio)
+	}
+
+	q, r := new(big.Int).QuoRem(m.Amount.int(), big.NewInt(int64(n)), new(big.Int))
+	as := make([]*big.Int, n)
+	for i := range as {
+		as[i] = new(big.Int).Set(q)
+	}
+
+	return parties(m, spreadLeftover(as, r)), nil
+}
+
+// AllocateBig works like money.Money.AllocateInt64 for BigMoney: parties get their share
+// of the amount in proportion to the ratios, leftover minor units are distributed one by
+// one to the first parties. When all ratios are zero all parties get zero.
+func AllocateBig(m *BigMoney, rs ...int64) ([]*BigMoney, error) {
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("%w: no ratios specified", money.ErrInvalidRatio)
+	}
+
+	sum := new(big.Int)
+	for _, r := range rs {
+		if r < 0 {
+			return nil, fmt.Errorf("%w: negative ratios not allowed", money.ErrInvalidRatio)
+		}
+		sum.Add(sum, big.NewInt(r))
+	}
+
+	a := m.Amount.int()
+	as := make([]*big.Int, len(rs))
+	total := new(big.Int)
+	for i, r := range rs {
+		as[i] = new(big.Int)
+		if sum.Sign() != 0 {
+			as[i].Quo(as[i].Mul(a, big.NewInt(r)), sum)
+		}
+		total.Add(total, as[i])
+	}
+
+	if sum.Sign() == 0 {
+		return parties(m, as), nil
+	}
+
+	return parties(m, spreadLeftover(as, total.Sub(a, total))), nil
+}
+
+// spreadLeftover distributes leftover minor units one by one to the first parties.
+func spreadLeftover(as []*big.Int, lo *big.Int) []*big.Int {
+	sub := big.NewInt(int64(lo.Sign()))
+	for p := 0; lo.Sign() != 0; p++ {
+		as[p].Add(as[p], sub)
+		lo.Sub(lo, sub)
+	}
+
+	return as
+}
+
+func parties(m *BigMoney, as []*big.Int) []*BigMoney {
+	ms := make([]*BigMoney, len(as))
+	for i, a := range as {
+		ms[i] = &BigMoney{Amount: Big{v: a}, Currency: m.Currency}
+	}
+
+	return ms
+}
@@ -0,0 +1,124 @@
+package moneygeneric
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func bigs(ms []*BigMoney) []string {
+	r := make([]string, len(ms))
+	for i, m := range ms {
+		r[i] = m.Amount.String()
+	}
+
+	return r
+}
+
+func TestBigMoney_Wei(t *testing.T) {
+	if err := money.RegisterCurrency(money.Currency{Code: "ETH", Fraction: 18, Grapheme: "Ξ", Template: "$1", Thousand: ","}); err != nil && !errors.Is(err, money.ErrCurrencyExists) {
+		t.Fatal(err)
+	}
+
+	wei, _ := new(big.Int).SetString("1000000000000000000000000", 10)
+	m := New(NewBig(wei), "ETH")
+
+	if r, expected := m.Display(), "Ξ1,000,000.000000000000000000"; r != expected {
+		t.Errorf("Expected %s got %s", expected, r)
+	}
+
+	parts, err := SplitBig(m, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"333333333333333333333334", "333333333333333333333333", "333333333333333333333333"}
+	for i, p := range bigs(parts) {
+		if p != expected[i] {
+			t.Errorf("Expected %v got %v", expected, bigs(parts))
+			break
+		}
+	}
+
+	if _, err := ToMoneyBig(m); !errors.Is(err, money.ErrOverflow) {
+		t.Errorf("Expected %v got %v", money.ErrOverflow, err)
+	}
+}
+
+func TestSplitBig(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		n        int
+		expected []string
+	}{
+		{10, 3, []string{"4", "3", "3"}},
+		{-10, 3, []string{"-4", "-3", "-3"}},
+		{2, 4, []string{"1", "1", "0", "0"}},
+		{0, 2, []string{"0", "0"}},
+	}
+
+	for _, tc := range tcs {
+		parts, err := SplitBig(New(NewBig(big.NewInt(tc.amount)), money.EUR), tc.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, p := range bigs(parts) {
+			if p != tc.expected[i] {
+				t.Errorf("Expected %v splitting %d got %v", tc.expected, tc.amount, bigs(parts))
+				break
+			}
+		}
+	}
+
+	if _, err := SplitBig(New(Big{}, money.EUR), 0); !errors.Is(err, money.ErrInvalidRatio) {
+		t.Errorf("Expected %v got %v", money.ErrInvalidRatio, err)
+	}
+}
+
+func TestAllocateBig(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	// Allocations of amounts fitting into money.Amount match money.Money.AllocateInt64.
+	for i := 0; i < 1000; i++ {
+		a := r.Int63() - r.Int63()
+		rs := make([]int64, 1+r.Intn(5))
+		for j := range rs {
+			rs[j] = r.Int63n(100)
+		}
+
+		m := money.New(a, money.EUR)
+		expected, err := m.AllocateInt64(rs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		parts, err := AllocateBig(FromMoneyBig(m), rs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for j, p := range parts {
+			if pm, err := ToMoneyBig(p); err != nil || pm.Amount != expected[j].Amount || pm.Currency != m.Currency {
+				t.Fatalf("Expected %d allocated by %v to be %v got %v", a, rs, expected, bigs(parts))
+			}
+		}
+	}
+
+	tcs := []struct {
+		rs  []int64
+		err error
+	}{
+		{nil, money.ErrInvalidRatio},
+		{[]int64{1, -1}, money.ErrInvalidRatio},
+	}
+
+	for _, tc := range tcs {
+		if _, err := AllocateBig(New(Big{}, money.EUR), tc.rs...); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %v got %v", tc.err, tc.rs, err)
+		}
+	}
+}
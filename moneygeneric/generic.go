@@ -0,0 +1,151 @@
+// Package moneygeneric provides Money parameterized by the type of its amount, so int64,
+// 128-bit and arbitrary precision amounts share one API. Currencies and formatting come
+// from the money package.
+package moneygeneric
+
+import (
+	"github.com/seth-duckinga/go-money"
+)
+
+// AmountConstraint is implemented by amount types Money can be parameterized with.
+// Amounts are values in minor units, operations return new amounts and report
+// money.ErrOverflow when the result doesn't fit into the type.
+type AmountConstraint[T any] interface {
+	Add(T) (T, error)
+	Sub(T) (T, error)
+	Cmp(T) int
+	Sign() int
+	// String returns the amount in minor units as decimal digits, e.g. "-1234".
+	String() string
+}
+
+// Money represents monetary value of amount type T in a Currency.
+type Money[T AmountConstraint[T]] struct {
+	Amount   T
+	Currency *money.Currency
+}
+
+// New creates and returns new instance of Money. Like money.New it panics when the code
+// is rejected, use NewWithOptions to get the error instead.
+func New[T AmountConstraint[T]](amount T, code string, opts ...money.Option) *Money[T] {
+	m, err := NewWithOptions(amount, code, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// NewWithOptions creates new instance of Money with the Currency resolved like
+// money.NewWithOptions does, returning its error for codes rejected by the
+// UnknownCodePolicy or the options.
+func NewWithOptions[T AmountConstraint[T]](amount T, code string, opts ...money.Option) (*Money[T], error) {
+	m, err := money.NewWithOptions(0, code, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money[T]{Amount: amount, Currency: m.Currency}, nil
+}
+
+// FromMoney converts money.Money into Money with an Int64 amount.
+func FromMoney(m *money.Money) *Money[Int64] {
+	return &Money[Int64]{Amount: Int64(m.Amount), Currency: m.Currency}
+}
+
+// ToMoney converts Money with an Int64 amount into money.Money.
+func ToMoney(m *Money[Int64]) *money.Money {
+	return &money.Money{Amount: money.Amount(m.Amount), Currency: m.Currency}
+}
+
+// SameCurrency check if given Money is equals by currency.
+func (m *Money[T]) SameCurrency(om *Money[T]) bool {
+	return om != nil && m.Currency != nil && om.Currency != nil && m.Currency.Code == om.Currency.Code
+}
+
+func (m *Money[T]) assertSameCurrency(om *Money[T]) error {
+	if om == nil {
+		return money.ErrNilMoney
+	}
+
+	if !m.SameCurrency(om) {
+		return &money.CurrencyMismatchError{A: code(m.Currency), B: code(om.Currency)}
+	}
+
+	return nil
+}
+
+// Add returns new Money struct with value representing sum of Self and Other Money.
+func (m *Money[T]) Add(om *Money[T]) (*Money[T], error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return nil, err
+	}
+
+	a, err := m.Amount.Add(om.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money[T]{Amount: a, Currency: m.Currency}, nil
+}
+
+// Subtract returns new Money struct with value representing difference of Self and Other Money.
+func (m *Money[T]) Subtract(om *Money[T]) (*Money[T], error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return nil, err
+	}
+
+	a, err := m.Amount.Sub(om.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money[T]{Amount: a, Currency: m.Currency}, nil
+}
+
+// Compare function compares two money of the same type
+//
+//	if m.Amount > om.Amount returns (1, nil)
+//	if m.Amount == om.Amount returns (0, nil)
+//	if m.Amount < om.Amount returns (-1, nil)
+func (m *Money[T]) Compare(om *Money[T]) (int, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return 0, err
+	}
+
+	return m.Amount.Cmp(om.Amount), nil
+}
+
+// Equals checks equality between two Money types.
+func (m *Money[T]) Equals(om *Money[T]) (bool, error) {
+	c, err := m.Compare(om)
+	return c == 0, err
+}
+
+// IsZero returns boolean of whether the value of Money is equals to zero.
+func (m *Money[T]) IsZero() bool {
+	return m.Amount.Sign() == 0
+}
+
+// IsPositive returns boolean of whether the value of Money is positive.
+func (m *Money[T]) IsPositive() bool {
+	return m.Amount.Sign() > 0
+}
+
+// IsNegative returns boolean of whether the value of Money is negative.
+func (m *Money[T]) IsNegative() bool {
+	return m.Amount.Sign() < 0
+}
+
+// Display lets represent Money struct as string in given Currency value.
+func (m *Money[T]) Display() string {
+	return m.Currency.Formatter().FormatDigits(m.Amount.String())
+}
+
+func code(c *money.Currency) string {
+	if c == nil {
+		return ""
+	}
+
+	return c.Code
+}
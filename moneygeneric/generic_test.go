@@ -0,0 +1,107 @@
+package moneygeneric
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func TestNewWithOptions(t *testing.T) {
+	m, err := NewWithOptions(Int64(1099), "usd")
+	if err != nil || m.Currency.Code != money.USD || m.Display() != "$10.99" {
+		t.Errorf("Expected %s got %v (%v)", "$10.99", m, err)
+	}
+
+	defer money.SetUnknownCodePolicy(nil)
+	money.SetUnknownCodePolicy(money.UnknownCodeError())
+
+	if _, err := NewWithOptions(Int64(1), "XYZ"); !errors.Is(err, money.ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", money.ErrUnsupportedCurrency, err)
+	}
+}
+
+func TestMoney_Add(t *testing.T) {
+	tcs := []struct {
+		a, b     Int64
+		expected string
+		err      error
+	}{
+		{5, 10, "€0.15", nil},
+		{-5, 2, "-€0.03", nil},
+		{math.MaxInt64, 1, "", money.ErrOverflow},
+		{math.MinInt64, -1, "", money.ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.a, money.EUR).Add(New(tc.b, money.EUR))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v adding %d and %d got %v", tc.err, tc.a, tc.b, err)
+			continue
+		}
+
+		if err == nil && r.Display() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r.Display())
+		}
+	}
+
+	if _, err := New(Int64(1), money.EUR).Add(New(Int64(1), money.USD)); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", money.ErrCurrencyMismatch, err)
+	}
+}
+
+func TestInt128(t *testing.T) {
+	upper := NewInt128(math.MaxInt64, math.MaxUint64)
+	lower := NewInt128(math.MinInt64, 0)
+	one := Int128From64(1)
+
+	if _, err := upper.Add(one); !errors.Is(err, money.ErrOverflow) {
+		t.Errorf("Expected %v got %v", money.ErrOverflow, err)
+	}
+
+	if _, err := lower.Sub(one); !errors.Is(err, money.ErrOverflow) {
+		t.Errorf("Expected %v got %v", money.ErrOverflow, err)
+	}
+
+	r, err := Int128From64(math.MaxInt64).Add(one)
+	if expected := "9223372036854775808"; err != nil || r.String() != expected {
+		t.Errorf("Expected %s got %s (%v)", expected, r, err)
+	}
+
+	r, err = Int128From64(-1).Sub(upper)
+	if err != nil || r.Cmp(lower) != 0 {
+		t.Errorf("Expected %s got %s (%v)", lower, r, err)
+	}
+
+	if r := Int128From64(-3).Sign(); r != -1 {
+		t.Errorf("Expected %d got %d", -1, r)
+	}
+}
+
+func TestMoney_Big(t *testing.T) {
+	v, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	m := New(NewBig(v), money.USD)
+
+	r, err := m.Subtract(New(NewBig(new(big.Int).Neg(v)), money.USD))
+	if expected := "$2,469,135,780,246,913,578,024,691,357.80"; err != nil || r.Display() != expected {
+		t.Errorf("Expected %s got %s (%v)", expected, r.Display(), err)
+	}
+
+	if m.Amount.String() != v.String() {
+		t.Errorf("Expected operands to be unchanged got %s", m.Amount)
+	}
+
+	if !New(Big{}, money.USD).IsZero() {
+		t.Error("Expected zero value of Big to be zero")
+	}
+}
+
+func TestFromMoney(t *testing.T) {
+	m := money.New(-1099, money.EUR)
+
+	if r := ToMoney(FromMoney(m)); r.Amount != m.Amount || r.Currency != m.Currency {
+		t.Errorf("Expected %v got %v", m, r)
+	}
+}
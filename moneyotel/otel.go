@@ -0,0 +1,47 @@
+// Package moneyotel provides OpenTelemetry attribute and metric helpers for money.Money.
+package moneyotel
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// CurrencyKey is the attribute key used for the currency code of recorded Money.
+const CurrencyKey = attribute.Key("currency")
+
+// Value returns the value of Money in major units as the float64 closest to
+// the exact decimal value, e.g. 10.99 for 1099 USD cents.
+func Value(m *money.Money) float64 {
+	f, _ := m.AsRat().Float64()
+	return f
+}
+
+// Currency returns the currency attribute of Money.
+func Currency(m *money.Money) attribute.KeyValue {
+	return CurrencyKey.String(m.Currency.Code)
+}
+
+// Attributes returns span attributes describing m under given key prefix:
+// <prefix>.amount in major units, <prefix>.amount_minor in minor units and <prefix>.currency.
+func Attributes(prefix string, m *money.Money) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Float64(prefix+".amount", Value(m)),
+		attribute.Int64(prefix+".amount_minor", int64(m.Amount)),
+		attribute.String(prefix+".currency", m.Currency.Code),
+	}
+}
+
+// Add adds m in major units to a counter, tagged with its currency.
+func Add(ctx context.Context, c metric.Float64Counter, m *money.Money, attrs ...attribute.KeyValue) {
+	c.Add(ctx, Value(m), metric.WithAttributes(append(slices.Clip(attrs), Currency(m))...))
+}
+
+// Record records m in major units into a histogram, tagged with its currency.
+func Record(ctx context.Context, h metric.Float64Histogram, m *money.Money, attrs ...attribute.KeyValue) {
+	h.Record(ctx, Value(m), metric.WithAttributes(append(slices.Clip(attrs), Currency(m))...))
+}
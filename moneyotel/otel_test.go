@@ -0,0 +1,71 @@
+package moneyotel
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func TestValue(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected float64
+	}{
+		{1099, money.USD, 10.99},
+		{-1, money.EUR, -0.01},
+		{1500, money.JPY, 1500},
+		{1, money.KWD, 0.001},
+	}
+
+	for _, tc := range tcs {
+		if v := Value(money.New(tc.amount, tc.code)); v != tc.expected {
+			t.Errorf("Expected %v got %v", tc.expected, v)
+		}
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	attrs := attribute.NewSet(Attributes("order.total", money.New(1099, money.EUR))...)
+
+	if v, _ := attrs.Value("order.total.amount"); v.AsFloat64() != 10.99 {
+		t.Errorf("Expected %v got %v", 10.99, v.AsFloat64())
+	}
+
+	if v, _ := attrs.Value("order.total.amount_minor"); v.AsInt64() != 1099 {
+		t.Errorf("Expected %v got %v", 1099, v.AsInt64())
+	}
+
+	if v, _ := attrs.Value("order.total.currency"); v.AsString() != money.EUR {
+		t.Errorf("Expected %v got %v", money.EUR, v.AsString())
+	}
+}
+
+func TestAdd(t *testing.T) {
+	meter := noop.NewMeterProvider().Meter("test")
+	c, _ := meter.Float64Counter("revenue")
+	h, _ := meter.Float64Histogram("order.value")
+
+	Add(context.Background(), c, money.New(1099, money.EUR))
+	Record(context.Background(), h, money.New(1099, money.EUR), attribute.String("channel", "web"))
+}
+
+func TestAdd_AttributesNotAliased(t *testing.T) {
+	meter := noop.NewMeterProvider().Meter("test")
+	c, _ := meter.Float64Counter("revenue")
+	h, _ := meter.Float64Histogram("order.value")
+
+	attrs := make([]attribute.KeyValue, 1, 2)
+	attrs[0] = attribute.String("channel", "web")
+
+	Add(context.Background(), c, money.New(1099, money.EUR), attrs...)
+	Record(context.Background(), h, money.New(1099, money.EUR), attrs...)
+
+	if spare := attrs[:2][1]; spare.Valid() {
+		t.Errorf("Expected attributes of the caller to be left alone got %v", spare)
+	}
+}
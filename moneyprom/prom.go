@@ -0,0 +1,98 @@
+// Package moneyprom records money.Money observations into Prometheus metrics
+// labelled by currency.
+package moneyprom
+
+import (
+	"slices"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// CurrencyLabel is the name of the label holding the currency code.
+const CurrencyLabel = "currency"
+
+// Counter is a Prometheus counter partitioned by currency, accumulating Money in major units.
+type Counter struct {
+	vec *prometheus.CounterVec
+}
+
+// NewCounter creates new Counter. The currency label is appended to given label names.
+func NewCounter(opts prometheus.CounterOpts, labelNames ...string) *Counter {
+	return &Counter{vec: prometheus.NewCounterVec(opts, append(slices.Clip(labelNames), CurrencyLabel))}
+}
+
+// Add adds m to the counter of its currency. Counters can't decrease,
+// so money.ErrInvalidAmount is returned for negative Money.
+func (c *Counter) Add(m *money.Money, labelValues ...string) error {
+	if m.IsNegative() {
+		return money.ErrInvalidAmount
+	}
+
+	counter, err := c.vec.GetMetricWithLabelValues(append(slices.Clip(labelValues), m.Currency.Code)...)
+	if err != nil {
+		return err
+	}
+	counter.Add(value(m))
+
+	return nil
+}
+
+// Describe implements prometheus.Collector.
+func (c *Counter) Describe(ch chan<- *prometheus.Desc) {
+	c.vec.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Counter) Collect(ch chan<- prometheus.Metric) {
+	c.vec.Collect(ch)
+}
+
+// Gauge is a Prometheus gauge partitioned by currency, holding Money in major units.
+type Gauge struct {
+	vec *prometheus.GaugeVec
+}
+
+// NewGauge creates new Gauge. The currency label is appended to given label names.
+func NewGauge(opts prometheus.GaugeOpts, labelNames ...string) *Gauge {
+	return &Gauge{vec: prometheus.NewGaugeVec(opts, append(slices.Clip(labelNames), CurrencyLabel))}
+}
+
+// Set sets the gauge of the currency of m to its value.
+func (g *Gauge) Set(m *money.Money, labelValues ...string) error {
+	gauge, err := g.vec.GetMetricWithLabelValues(append(slices.Clip(labelValues), m.Currency.Code)...)
+	if err != nil {
+		return err
+	}
+	gauge.Set(value(m))
+
+	return nil
+}
+
+// Add adds m to the gauge of its currency.
+func (g *Gauge) Add(m *money.Money, labelValues ...string) error {
+	gauge, err := g.vec.GetMetricWithLabelValues(append(slices.Clip(labelValues), m.Currency.Code)...)
+	if err != nil {
+		return err
+	}
+	gauge.Add(value(m))
+
+	return nil
+}
+
+// Describe implements prometheus.Collector.
+func (g *Gauge) Describe(ch chan<- *prometheus.Desc) {
+	g.vec.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (g *Gauge) Collect(ch chan<- prometheus.Metric) {
+	g.vec.Collect(ch)
+}
+
+// value returns Money in major units as the closest float64.
+func value(m *money.Money) float64 {
+	f, _ := m.AsRat().Float64()
+	return f
+}
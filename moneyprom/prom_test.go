@@ -0,0 +1,71 @@
+package moneyprom
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter(prometheus.CounterOpts{Name: "revenue_total"}, "channel")
+
+	if err := c.Add(money.New(1099, money.EUR), "web"); err != nil {
+		t.Fatal(err)
+	}
+	_ = c.Add(money.New(1, money.EUR), "web")
+	_ = c.Add(money.New(1500, money.JPY), "web")
+
+	if v := testutil.ToFloat64(c.vec.WithLabelValues("web", money.EUR)); v != 11 {
+		t.Errorf("Expected %v got %v", 11, v)
+	}
+
+	if v := testutil.ToFloat64(c.vec.WithLabelValues("web", money.JPY)); v != 1500 {
+		t.Errorf("Expected %v got %v", 1500, v)
+	}
+
+	if err := c.Add(money.New(-1, money.EUR), "web"); !errors.Is(err, money.ErrInvalidAmount) {
+		t.Errorf("Expected %v got %v", money.ErrInvalidAmount, err)
+	}
+
+	if err := c.Add(money.New(1, money.EUR)); err == nil {
+		t.Error("Expected err for missing label value")
+	}
+
+	if n := testutil.CollectAndCount(c); n != 2 {
+		t.Errorf("Expected %d series got %d", 2, n)
+	}
+}
+
+func TestGauge(t *testing.T) {
+	g := NewGauge(prometheus.GaugeOpts{Name: "balance"})
+
+	_ = g.Set(money.New(1000, money.USD))
+	_ = g.Add(money.New(-250, money.USD))
+
+	if v := testutil.ToFloat64(g.vec.WithLabelValues(money.USD)); v != 7.5 {
+		t.Errorf("Expected %v got %v", 7.5, v)
+	}
+}
+
+func TestCounter_LabelsNotAliased(t *testing.T) {
+	names := make([]string, 1, 2)
+	names[0] = "channel"
+	c := NewCounter(prometheus.CounterOpts{Name: "orders_total"}, names...)
+
+	values := make([]string, 1, 2)
+	values[0] = "web"
+	_ = c.Add(money.New(1, money.EUR), values...)
+	_ = c.Add(money.New(1, money.USD), values...)
+
+	if spare := values[:2][1]; spare != "" {
+		t.Errorf("Expected label values of the caller to be left alone got %q", spare)
+	}
+
+	if spare := names[:2][1]; spare != "" {
+		t.Errorf("Expected label names of the caller to be left alone got %q", spare)
+	}
+}
@@ -0,0 +1,63 @@
+package moneytest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// AssertEqual fails the test when got isn't equal to want by amount and currency code.
+func AssertEqual(t testing.TB, want, got *money.Money) {
+	t.Helper()
+
+	if !want.Equal(got) {
+		t.Errorf("Money not equal:\n\twant: %s\n\t got: %s", describe(want), describe(got))
+	}
+}
+
+// AssertEqualSlices fails the test when the slices differ in length or in Money at any index,
+// reporting every mismatching index.
+func AssertEqualSlices(t testing.TB, want, got []*money.Money) {
+	t.Helper()
+
+	ds := money.DiffSlices(want, got)
+	if len(ds) == 0 {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Money slices differ at %d of %d indexes:", len(ds), max(len(want), len(got)))
+	for _, d := range ds {
+		fmt.Fprintf(&b, "\n\t[%d] want: %s\n\t[%d]  got: %s", d.Index, describe(d.A), d.Index, describe(d.B))
+	}
+
+	t.Error(b.String())
+}
+
+// AssertSumPreserved fails the test when parts don't sum exactly to original, e.g. after
+// a split or an allocation lost or created pennies.
+func AssertSumPreserved(t testing.TB, original *money.Money, parts []*money.Money) {
+	t.Helper()
+
+	sum, err := money.Sum(parts...)
+	if err != nil {
+		t.Errorf("Can't sum %d parts: %v", len(parts), err)
+		return
+	}
+
+	if !original.Equal(sum) {
+		t.Errorf("Sum of %d parts not preserved:\n\toriginal: %s\n\t     sum: %s", len(parts), describe(original), describe(sum))
+	}
+}
+
+// describe formats Money with both its amount in minor units and its display form,
+// so amounts with the same display in different currencies can be told apart.
+func describe(m *money.Money) string {
+	if !m.IsValid() {
+		return fmt.Sprintf("%+v", m)
+	}
+
+	return fmt.Sprintf("%d %s (%s)", m.Amount, m.Currency.Code, m.Display())
+}
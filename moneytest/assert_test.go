@@ -0,0 +1,66 @@
+package moneytest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// recorder is a testing.TB recording failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Error(args ...any) {
+	r.errors = append(r.errors, fmt.Sprint(args...))
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertEqual(t *testing.T) {
+	r := &recorder{TB: t}
+
+	AssertEqual(r, money.New(1099, money.EUR), money.New(1099, money.EUR))
+	if len(r.errors) != 0 {
+		t.Errorf("Expected no failures got %v", r.errors)
+	}
+
+	AssertEqual(r, money.New(1099, money.EUR), money.New(1099, money.USD))
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "1099 USD ($10.99)") {
+		t.Errorf("Expected a failure describing USD got %v", r.errors)
+	}
+}
+
+func TestAssertEqualSlices(t *testing.T) {
+	r := &recorder{TB: t}
+
+	want := []*money.Money{money.New(1, money.EUR), money.New(2, money.EUR)}
+	AssertEqualSlices(r, want, []*money.Money{money.New(1, money.EUR), money.New(3, money.EUR)})
+
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "[1]  got: 3 EUR") {
+		t.Errorf("Expected a failure at index 1 got %v", r.errors)
+	}
+}
+
+func TestAssertSumPreserved(t *testing.T) {
+	r := &recorder{TB: t}
+
+	original := money.New(100, money.EUR)
+	parts, _ := original.Split(3)
+	AssertSumPreserved(r, original, parts)
+	if len(r.errors) != 0 {
+		t.Errorf("Expected no failures got %v", r.errors)
+	}
+
+	AssertSumPreserved(r, original, parts[:2])
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "sum: 67 EUR") {
+		t.Errorf("Expected a failure got %v", r.errors)
+	}
+}
@@ -0,0 +1,18 @@
+// Package moneytest provides helpers for testing code that handles money.Money.
+package moneytest
+
+import (
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// Comparer returns a cmp.Option comparing Money by amount and currency code, so
+// structs holding Money compare equal regardless of the identity or the formatting
+// fields of their Currency.
+func Comparer() cmp.Option {
+	return cmp.Options{
+		cmp.Comparer(func(a, b *money.Money) bool { return a.Equal(b) }),
+		cmp.Comparer(func(a, b money.Money) bool { return a.Equal(&b) }),
+	}
+}
@@ -0,0 +1,38 @@
+package moneytest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+type invoice struct {
+	ID    string
+	Total *money.Money
+	Tax   money.Money
+}
+
+func TestComparer(t *testing.T) {
+	want := invoice{ID: "1", Total: money.New(1099, money.EUR), Tax: *money.New(190, money.EUR)}
+	got := invoice{
+		ID:    "1",
+		Total: &money.Money{Amount: 1099, Currency: &money.Currency{Code: money.EUR}},
+		Tax:   money.Money{Amount: 190, Currency: &money.Currency{Code: money.EUR}},
+	}
+
+	if d := cmp.Diff(want, got, Comparer()); d != "" {
+		t.Errorf("Expected no diff got %s", d)
+	}
+
+	got.Total = money.New(1099, money.USD)
+	if cmp.Equal(want, got, Comparer()) {
+		t.Error("Expected invoices with different currencies to differ")
+	}
+
+	got.Total, want.Total = nil, nil
+	if !cmp.Equal(want, got, Comparer()) {
+		t.Error("Expected invoices with nil totals to be equal")
+	}
+}
@@ -0,0 +1,60 @@
+package moneytest
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+
+	"pgregory.net/rapid"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// codes are the currencies Money is generated in, covering all fraction sizes.
+var codes = []string{
+	money.USD, money.EUR, money.GBP, money.CHF, // 2 decimals
+	money.JPY, money.KRW, money.ISK, // no decimals
+	money.KWD, money.BHD, money.TND, // 3 decimals
+	money.CLF, // 4 decimals
+}
+
+// Money wraps money.Money to implement quick.Generator, so testing/quick can
+// generate it for property functions, e.g. quick.Check(func(m moneytest.Money) bool { ... }, nil).
+type Money struct {
+	*money.Money
+}
+
+// Generate implements quick.Generator.
+func (Money) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(Money{Generate(r)})
+}
+
+// Generate returns random valid Money using r. A quarter of the amounts are boundary
+// values like zero, ±1, one major unit and the limits of money.Amount.
+func Generate(r *rand.Rand) *money.Money {
+	code := codes[r.Intn(len(codes))]
+	if r.Intn(4) == 0 {
+		bs := boundaries(code)
+		return money.New(bs[r.Intn(len(bs))], code)
+	}
+
+	return money.New(r.Int63()-r.Int63(), code)
+}
+
+// Rapid returns a pgregory.net/rapid generator of valid Money with the same
+// distribution of currencies and boundary amounts as Generate.
+func Rapid() *rapid.Generator[*money.Money] {
+	return rapid.Custom(func(t *rapid.T) *money.Money {
+		code := rapid.SampledFrom(codes).Draw(t, "code")
+		amount := rapid.OneOf(rapid.SampledFrom(boundaries(code)), rapid.Int64()).Draw(t, "amount")
+
+		return money.New(amount, code)
+	})
+}
+
+// boundaries returns the amounts most likely to uncover bugs in Money handling code.
+func boundaries(code string) []int64 {
+	unit := int64(math.Pow10(money.GetCurrency(code).Fraction))
+
+	return []int64{0, 1, -1, unit, -unit, unit - 1, math.MaxInt64, math.MinInt64, math.MaxInt64 - 1, math.MinInt64 + 1}
+}
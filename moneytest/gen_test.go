@@ -0,0 +1,34 @@
+package moneytest
+
+import (
+	"testing"
+	"testing/quick"
+
+	"pgregory.net/rapid"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func TestMoney_Generate(t *testing.T) {
+	valid := func(m Money) bool {
+		return m.IsValid() && money.GetCurrency(m.Currency.Code) != nil
+	}
+
+	if err := quick.Check(valid, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRapid(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		m := Rapid().Draw(t, "money")
+		if !m.IsValid() || money.GetCurrency(m.Currency.Code) == nil {
+			t.Fatalf("Expected valid Money got %v", m)
+		}
+
+		k, err := money.FromKey(m.Key())
+		if err != nil || !k.Equal(m) {
+			t.Fatalf("Expected %v got %v (%v)", m, k, err)
+		}
+	})
+}
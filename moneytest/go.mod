@@ -0,0 +1,11 @@
+module github.com/seth-duckinga/go-money/moneytest
+
+go 1.23
+
+require (
+	github.com/google/go-cmp v0.6.0
+	github.com/seth-duckinga/go-money v0.0.0
+	pgregory.net/rapid v1.1.0
+)
+
+replace github.com/seth-duckinga/go-money => ../
@@ -0,0 +1,12 @@
+module github.com/seth-duckinga/go-money/moneyzap
+
+go 1.23
+
+require (
+	github.com/seth-duckinga/go-money v0.0.0
+	go.uber.org/zap v1.27.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
+
+replace github.com/seth-duckinga/go-money => ../
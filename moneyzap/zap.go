@@ -0,0 +1,36 @@
+// Package moneyzap provides zap logging support for money.Money.
+package moneyzap
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// Object wraps Money to implement zapcore.ObjectMarshaler.
+// Only the amount and currency code are encoded, so logging doesn't allocate
+// for formatting on hot paths.
+type Object struct {
+	*money.Money
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler.
+func (o Object) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt64("amount", int64(o.Amount))
+	if o.Currency != nil {
+		enc.AddString("currency", o.Currency.Code)
+	}
+
+	return nil
+}
+
+// Field returns a zap.Field logging m as an object with amount and currency keys.
+// A nil Money is logged as null.
+func Field(key string, m *money.Money) zap.Field {
+	if m == nil {
+		return zap.Reflect(key, nil)
+	}
+
+	return zap.Object(key, Object{m})
+}
@@ -0,0 +1,39 @@
+package moneyzap
+
+import (
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func TestField(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	logger := zap.New(core)
+
+	logger.Info("charged", Field("price", money.New(1099, money.EUR)), Field("refund", nil))
+
+	ctx := logs.All()[0].ContextMap()
+	expected := map[string]interface{}{"amount": int64(1099), "currency": money.EUR}
+
+	if !reflect.DeepEqual(ctx["price"], expected) {
+		t.Errorf("Expected %v got %v", expected, ctx["price"])
+	}
+
+	if ctx["refund"] != nil {
+		t.Errorf("Expected nil got %v", ctx["refund"])
+	}
+}
+
+func BenchmarkField(b *testing.B) {
+	logger := zap.NewNop()
+	m := money.New(1099, money.EUR)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		logger.Info("charged", Field("price", m))
+	}
+}
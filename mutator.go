@@ -1,5 +1,8 @@
 package money
 
+// mutator holds the built-in calculator, backing DefaultCalculator and the aggregates.
+// calc is a concrete type rather than an interface, so its trivial methods are inlined
+// into the callers and compile down to plain integer operations.
 type mutator struct {
 	calc *calculator
 }
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"strings"
+)
+
+// ParseOFXAmount parses an OFX amount value (TRNAMT, BALAMT, ...) like "-123.45" into Money.
+// OFX amounts carry an optional sign and use either "." or "," as decimal separator,
+// without any thousand separators.
+func ParseOFXAmount(s, code string) (*Money, error) {
+	s = strings.TrimSpace(s)
+	// SGML based OFX 1.x files may or may not close the element.
+	s, _, _ = strings.Cut(s, "<")
+	s = strings.TrimSpace(strings.Replace(s, ",", ".", 1))
+
+	m, err := NewWithOptions(0, code)
+	if err != nil {
+		return nil, err
+	}
+	a, err := parseDecimal(s, m.Currency.Fraction)
+	if err != nil {
+		return nil, err
+	}
+	m.Amount = a
+
+	return m, nil
+}
+
+// ParseOFXTransaction parses the amount of an OFX STMTTRN aggregate into Money.
+// The transaction currency is taken from the CURRENCY aggregate when present, otherwise
+// defaultCode (usually the statement CURDEF) is used. An ORIGCURRENCY aggregate only
+// tells the currency the transaction was converted from, TRNAMT is in CURDEF then.
+func ParseOFXTransaction(stmttrn, defaultCode string) (*Money, error) {
+	v, ok := ofxElement(stmttrn, "TRNAMT")
+	if !ok {
+		return nil, ErrInvalidAmount
+	}
+
+	code := defaultCode
+	if cur, ok := ofxAggregate(stmttrn, "CURRENCY"); ok {
+		if c, ok := ofxElement(cur, "CURSYM"); ok && c != "" {
+			code = c
+		}
+	}
+
+	return ParseOFXAmount(v, code)
+}
+
+// ofxAggregate returns the content of the first aggregate with given name. Aggregates
+// are closed in both SGML and XML, unclosed ones extend to the end of s.
+func ofxAggregate(s, name string) (string, bool) {
+	v, ok := ofxElementStart(s, "<"+name+">")
+	if !ok {
+		return "", false
+	}
+
+	if j := strings.Index(strings.ToUpper(v), "</"+strings.ToUpper(name)+">"); j >= 0 {
+		v = v[:j]
+	}
+
+	return v, true
+}
+
+// ofxElement returns the value of the first element with given name.
+// It supports both SGML (unclosed) and XML (closed) element styles.
+func ofxElement(s, name string) (string, bool) {
+	v, ok := ofxElementStart(s, "<"+name+">")
+	if !ok {
+		return "", false
+	}
+
+	if j := strings.IndexAny(v, "<\r\n"); j >= 0 {
+		v = v[:j]
+	}
+
+	return strings.TrimSpace(v), true
+}
+
+// ofxElementStart returns what follows the first occurrence of tag, matched case
+// insensitively.
+func ofxElementStart(s, tag string) (string, bool) {
+	for i := 0; i+len(tag) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(tag)], tag) {
+			return s[i+len(tag):], true
+		}
+	}
+
+	return "", false
+}
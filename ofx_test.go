@@ -0,0 +1,64 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseOFXAmount(t *testing.T) {
+	tcs := []struct {
+		value    string
+		code     string
+		expected Amount
+		err      error
+	}{
+		{"-123.45", USD, -12345, nil},
+		{"+10", USD, 1000, nil},
+		{"123,45", EUR, 12345, nil},
+		{" 7.50</TRNAMT>", EUR, 750, nil},
+		{"10.5000", USD, 1050, nil},
+		{"1500", JPY, 1500, nil},
+		{"10.555", USD, 0, ErrPrecisionLoss},
+		{"1,234.56", USD, 0, ErrInvalidAmount},
+		{"", USD, 0, ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		m, err := ParseOFXAmount(tc.value, tc.code)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %q got %v", tc.err, tc.value, err)
+			continue
+		}
+
+		if err == nil && m.Amount != tc.expected {
+			t.Errorf("Expected %d for %q got %d", tc.expected, tc.value, m.Amount)
+		}
+	}
+}
+
+func TestParseOFXTransaction(t *testing.T) {
+	tcs := []struct {
+		stmttrn  string
+		amount   Amount
+		currency string
+	}{
+		{"<STMTTRN>\n<TRNTYPE>DEBIT\n<TRNAMT>-42.10\n<FITID>1\n</STMTTRN>", -4210, USD},
+		{"<STMTTRN><TRNAMT>12.00</TRNAMT><CURRENCY><CURRATE>1.1<CURSYM>EUR</CURRENCY></STMTTRN>", 1200, EUR},
+		{"<STMTTRN><TRNAMT>12.00</TRNAMT><ORIGCURRENCY><CURRATE>1.1<CURSYM>EUR</ORIGCURRENCY></STMTTRN>", 1200, USD},
+		{"<STMTTRN>\n<TRNAMT>-5.00\n<ORIGCURRENCY>\n<CURRATE>150\n<CURSYM>JPY\n</ORIGCURRENCY>\n</STMTTRN>", -500, USD},
+		{"<STMTTRN>\n<TRNAMT>-5.00\n<currency>\n<CURRATE>1.1\n<CURSYM>EUR\n</currency>\n</STMTTRN>", -500, EUR},
+	}
+
+	for _, tc := range tcs {
+		m, err := ParseOFXTransaction(tc.stmttrn, USD)
+
+		if err != nil || m.Amount != tc.amount || m.Currency.Code != tc.currency {
+			t.Errorf("Expected %d %s got %v (%v)", tc.amount, tc.currency, m, err)
+		}
+	}
+
+	if _, err := ParseOFXTransaction("<STMTTRN><FITID>1</STMTTRN>", USD); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected %v got %v", ErrInvalidAmount, err)
+	}
+}
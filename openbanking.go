@@ -0,0 +1,125 @@
+package money
+
+import (
+	"strings"
+)
+
+// OpenBankingAmount represents the UK Open Banking amount object,
+// e.g. {"Amount": "20.00", "Currency": "GBP"}.
+// Amounts are non-negative with up to 13 integer and 5 fraction digits.
+type OpenBankingAmount struct {
+	Amount   string `json:"Amount"`
+	Currency string `json:"Currency"`
+}
+
+// BerlinGroupAmount represents the NextGenPSD2 (Berlin Group) amount object used by
+// EU PSD2 APIs, e.g. {"currency": "EUR", "amount": "-20.5"}.
+// Amounts have an optional sign with up to 14 integer and 3 fraction digits.
+type BerlinGroupAmount struct {
+	Currency string `json:"currency"`
+	Amount   string `json:"amount"`
+}
+
+// amountRules describes the string precision rules of an amount object.
+type amountRules struct {
+	integer  int
+	fraction int
+	negative bool
+}
+
+var (
+	openBankingRules = amountRules{integer: 13, fraction: 5}
+	berlinGroupRules = amountRules{integer: 14, fraction: 3, negative: true}
+)
+
+// ToOpenBanking converts Money into UK Open Banking amount object.
+func (m *Money) ToOpenBanking() (*OpenBankingAmount, error) {
+	c := m.Currency.get()
+	s, err := openBankingRules.format(m.Amount, c.Fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenBankingAmount{Amount: s, Currency: c.Code}, nil
+}
+
+// NewFromOpenBanking creates and returns new instance of Money from UK Open Banking amount object.
+func NewFromOpenBanking(a OpenBankingAmount) (*Money, error) {
+	return openBankingRules.parse(a.Amount, a.Currency)
+}
+
+// ToBerlinGroup converts Money into NextGenPSD2 amount object.
+func (m *Money) ToBerlinGroup() (*BerlinGroupAmount, error) {
+	c := m.Currency.get()
+	s, err := berlinGroupRules.format(m.Amount, c.Fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BerlinGroupAmount{Currency: c.Code, Amount: s}, nil
+}
+
+// NewFromBerlinGroup creates and returns new instance of Money from NextGenPSD2 amount object.
+func NewFromBerlinGroup(a BerlinGroupAmount) (*Money, error) {
+	return berlinGroupRules.parse(a.Amount, a.Currency)
+}
+
+func (r amountRules) format(amount Amount, fraction int) (string, error) {
+	if amount < 0 && !r.negative {
+		return "", ErrInvalidAmount
+	}
+
+	d := fraction
+	if d > r.fraction {
+		d = r.fraction
+	}
+
+	a, err := rescaleAmount(amount, fraction, d)
+	if err != nil {
+		return "", err
+	}
+
+	s := formatDecimal(a, d)
+	if err := r.validate(s); err != nil {
+		return "", err
+	}
+
+	return s, nil
+}
+
+func (r amountRules) parse(s, code string) (*Money, error) {
+	if err := r.validate(s); err != nil {
+		return nil, err
+	}
+
+	if len(code) != 3 {
+		return nil, &UnsupportedCurrencyError{Code: code}
+	}
+
+	m, err := NewWithOptions(0, code)
+	if err != nil {
+		return nil, err
+	}
+	a, err := parseDecimal(s, m.Currency.Fraction)
+	if err != nil {
+		return nil, err
+	}
+	m.Amount = a
+
+	return m, nil
+}
+
+// validate checks s against the allowed sign and number of integer and fraction digits.
+func (r amountRules) validate(s string) error {
+	if strings.HasPrefix(s, "-") && r.negative {
+		s = s[1:]
+	}
+
+	ip, fp, hasPoint := strings.Cut(s, ".")
+	if ip == "" || len(ip) > r.integer || !isDigits(ip) ||
+		(hasPoint && (fp == "" || len(fp) > r.fraction || !isDigits(fp))) {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
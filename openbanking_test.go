@@ -0,0 +1,84 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMoney_ToOpenBanking(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+		err      error
+	}{
+		{2000, GBP, `{"Amount":"20.00","Currency":"GBP"}`, nil},
+		{1500, JPY, `{"Amount":"1500","Currency":"JPY"}`, nil},
+		{-2000, GBP, "", ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		a, err := New(tc.amount, tc.code).ToOpenBanking()
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v got %v", tc.err, err)
+			continue
+		}
+
+		if err == nil {
+			if b, _ := json.Marshal(a); string(b) != tc.expected {
+				t.Errorf("Expected %s got %s", tc.expected, b)
+			}
+		}
+	}
+}
+
+func TestNewFromOpenBanking(t *testing.T) {
+	tcs := []struct {
+		amount   OpenBankingAmount
+		expected Amount
+		err      error
+	}{
+		{OpenBankingAmount{Amount: "20.00", Currency: GBP}, 2000, nil},
+		{OpenBankingAmount{Amount: "20", Currency: GBP}, 2000, nil},
+		{OpenBankingAmount{Amount: "20.50000", Currency: GBP}, 2050, nil},
+		{OpenBankingAmount{Amount: "20.000001", Currency: GBP}, 0, ErrInvalidAmount},
+		{OpenBankingAmount{Amount: "20.001", Currency: GBP}, 0, ErrPrecisionLoss},
+		{OpenBankingAmount{Amount: "-20.00", Currency: GBP}, 0, ErrInvalidAmount},
+		{OpenBankingAmount{Amount: "12345678901234", Currency: GBP}, 0, ErrInvalidAmount},
+		{OpenBankingAmount{Amount: "20.00", Currency: "gbp"}, 2000, nil},
+		{OpenBankingAmount{Amount: "20.00", Currency: "GB"}, 0, ErrUnsupportedCurrency},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromOpenBanking(tc.amount)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %+v got %v", tc.err, tc.amount, err)
+			continue
+		}
+
+		if err == nil && m.Amount != tc.expected {
+			t.Errorf("Expected %d got %d", tc.expected, m.Amount)
+		}
+	}
+}
+
+func TestBerlinGroupAmount(t *testing.T) {
+	a, err := New(-2050, EUR).ToBerlinGroup()
+
+	if err != nil || a.Amount != "-20.50" || a.Currency != EUR {
+		t.Errorf("Expected %s %s got %+v (%v)", "-20.50", EUR, a, err)
+	}
+
+	m, err := NewFromBerlinGroup(BerlinGroupAmount{Currency: EUR, Amount: "-20.5"})
+	if err != nil || m.Amount != -2050 {
+		t.Errorf("Expected %d got %v (%v)", -2050, m, err)
+	}
+
+	_, err = NewFromBerlinGroup(BerlinGroupAmount{Currency: EUR, Amount: "1.0000"})
+	if !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected %v got %v", ErrInvalidAmount, err)
+	}
+}
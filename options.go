@@ -0,0 +1,81 @@
+package money
+
+import "fmt"
+
+// Option customizes Money created by New and NewWithOptions.
+type Option func(o *options)
+
+type options struct {
+	registry  Currencies
+	fraction  *int
+	formatter *Formatter
+	strict    bool
+}
+
+// WithRegistry looks the currency code up in given Currencies instead of the currencies
+// known to the package.
+func WithRegistry(registry Currencies) Option {
+	return func(o *options) {
+		o.registry = registry
+	}
+}
+
+// WithFraction overrides the number of fraction digits of the Currency. NewWithOptions
+// rejects fractions outside of [0, 18] with ErrInvalidCurrency.
+func WithFraction(fraction int) Option {
+	return func(o *options) {
+		o.fraction = &fraction
+	}
+}
+
+// WithFormatter overrides how the Currency is displayed. The fraction digits of
+// the Formatter apply to the Currency too.
+func WithFormatter(f *Formatter) Option {
+	return func(o *options) {
+		o.formatter = f
+	}
+}
+
+// WithStrictCode rejects currency codes not found in the registry with UnsupportedCurrencyError
+// instead of resolving them with the UnknownCodePolicy.
+func WithStrictCode() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// NewWithOptions creates and returns new instance of Money customized by given options.
+// The Currency of the Money is a copy detached from the registry, so options changing it
+// and later modifications don't affect other Money.
+func NewWithOptions(amount int64, code string, opts ...Option) (*Money, error) {
+	o := options{registry: loadRegistry()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	c := o.registry.CurrencyByCode(code).clone()
+	if c == nil {
+		if o.strict {
+			return nil, &UnsupportedCurrencyError{Code: code}
+		}
+
+		var err error
+		if c, err = unknownCode(code); err != nil {
+			return nil, err
+		}
+	}
+
+	if f := o.formatter; f != nil {
+		c.Fraction, c.Decimal, c.Thousand, c.Grapheme, c.Template = f.Fraction, f.Decimal, f.Thousand, f.Grapheme, f.Template
+	}
+
+	if o.fraction != nil {
+		c.Fraction = *o.fraction
+	}
+
+	if c.Fraction < 0 || c.Fraction > 18 {
+		return nil, fmt.Errorf("%w: fraction %d of %s", ErrInvalidCurrency, c.Fraction, c.Code)
+	}
+
+	return &Money{Amount: Amount(amount), Currency: c}, nil
+}
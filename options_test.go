@@ -0,0 +1,48 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewWithOptions(t *testing.T) {
+	m := New(1234, EUR, WithFraction(3))
+	if m.Currency.Fraction != 3 || m.Display() != "€1.234" || GetCurrency(EUR).Fraction != 2 {
+		t.Errorf("Expected %s got %s", "€1.234", m.Display())
+	}
+
+	m = New(123456, USD, WithFormatter(NewFormatter(2, ",", ".", "US$", "1 $")))
+	if r, expected := m.Display(), "1.234,56 US$"; r != expected {
+		t.Errorf("Expected %s got %s", expected, r)
+	}
+
+	registry := Currencies{"PTS": {Code: "PTS", Fraction: 0, Grapheme: "pts", Template: "1 $"}}
+	m, err := NewWithOptions(1500, "pts", WithRegistry(registry), WithStrictCode())
+	if err != nil || m.Display() != "1500 pts" {
+		t.Errorf("Expected %s got %v (%v)", "1500 pts", m, err)
+	}
+
+	if _, err := NewWithOptions(1, EUR, WithRegistry(registry), WithStrictCode()); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+
+	if m := New(1, "XYZ", WithFraction(1)); m.Display() != "0.1XYZ" {
+		t.Errorf("Expected %s got %s", "0.1XYZ", m.Display())
+	}
+
+	for _, opt := range []Option{WithFraction(-1), WithFraction(19), WithFormatter(NewFormatter(20, ".", ",", "$", "$1"))} {
+		if _, err := NewWithOptions(1, USD, opt); !errors.Is(err, ErrInvalidCurrency) {
+			t.Errorf("Expected %v got %v", ErrInvalidCurrency, err)
+		}
+	}
+}
+
+func TestNew_StrictPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected New to panic")
+		}
+	}()
+
+	New(1, "XYZ", WithStrictCode())
+}
@@ -0,0 +1,136 @@
+package money
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Parse parses a displayed amount like "$1,234.56", "-$12.00" or "$-12.00" into Money of
+// given currency code, using the separators and symbol of its Currency, so it accepts
+// what Display returns. Options customize the Currency like with NewWithOptions, e.g.
+// WithFormatter to parse "1.234,56 €" as euros.
+//
+// See Formatter.Parse for the accepted input, the currency code is accepted in place of
+// the grapheme too, e.g. "USD 1,234.56". Parse returns the errors of NewWithOptions for
+// the code and ParseError for malformed input.
+func Parse(s, code string, opts ...Option) (*Money, error) {
+	m, err := NewWithOptions(0, code, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c := m.Currency.get()
+	a, err := c.Formatter().parse(s, c.Grapheme, c.Code)
+	if err != nil {
+		return nil, err
+	}
+	m.Amount = Amount(a)
+
+	return m, nil
+}
+
+// Parse parses an amount formatted by Format back into minor units. It's lenient about
+// how the amount is laid out rather than requiring the exact Template:
+//
+//   - The grapheme may come before or after the number, or be left out.
+//   - A minus sign may come before the grapheme or the number, e.g. "-$12.00" and "$-12.00".
+//   - Thousand separators are optional, but must group the integer digits by three.
+//   - Spaces around the number and the grapheme are ignored, a space Thousand separator
+//     matches any space, like the no-break spaces of locale aware formatting.
+//   - Fraction digits may be left out, extra fraction digits must be zeros.
+//
+// Failures are reported as ParseError wrapping ErrInvalidAmount for malformed input,
+// ErrPrecisionLoss for non-zero extra fraction digits and ErrOverflow for amounts that
+// don't fit into int64.
+func (f *Formatter) Parse(s string) (int64, error) {
+	return f.parse(s, f.Grapheme)
+}
+
+// parse works like Parse, accepting any of given symbols in place of the grapheme.
+func (f *Formatter) parse(s string, symbols ...string) (int64, error) {
+	in := s
+	invalid := &ParseError{Input: in, Err: ErrInvalidAmount}
+
+	s, neg := cutMinus(strings.TrimFunc(s, unicode.IsSpace))
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	for _, sym := range symbols {
+		if sym == "" {
+			continue
+		}
+
+		if rest, ok := strings.CutPrefix(s, sym); ok {
+			s = rest
+			break
+		}
+		if rest, ok := strings.CutSuffix(s, sym); ok {
+			s = rest
+			break
+		}
+	}
+
+	s = strings.TrimFunc(s, unicode.IsSpace)
+	if !neg {
+		s, neg = cutMinus(s)
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	}
+
+	ip, fp, hasPoint := s, "", false
+	if f.Decimal != "" {
+		ip, fp, hasPoint = strings.Cut(s, f.Decimal)
+	}
+
+	if th := f.Thousand; th != "" {
+		if strings.TrimFunc(th, unicode.IsSpace) == "" {
+			ip = strings.Map(func(r rune) rune {
+				if unicode.IsSpace(r) {
+					return ' '
+				}
+				return r
+			}, ip)
+			th = " "
+		}
+
+		if groups := strings.Split(ip, th); len(groups) > 1 {
+			for i, g := range groups {
+				if (i == 0 && (g == "" || len(g) > 3)) || (i > 0 && len(g) != 3) {
+					return 0, invalid
+				}
+			}
+			ip = strings.Join(groups, "")
+		}
+	}
+
+	if ip == "" || !isDigits(ip) || !isDigits(fp) {
+		return 0, invalid
+	}
+
+	d := ip
+	if hasPoint {
+		d += "." + fp
+	}
+	if neg {
+		d = "-" + d
+	}
+
+	a, err := parseDecimal(d, f.Fraction)
+	if err != nil {
+		// Report the input as given rather than its plain decimal form.
+		if perr, ok := err.(*ParseError); ok {
+			perr.Input = in
+		}
+		return 0, err
+	}
+
+	return int64(a), nil
+}
+
+// cutMinus removes a leading minus sign from s, accepting the Unicode minus as well.
+func cutMinus(s string) (string, bool) {
+	for _, sign := range []string{"-", "−"} {
+		if rest, ok := strings.CutPrefix(s, sign); ok {
+			return rest, true
+		}
+	}
+
+	return s, false
+}
@@ -0,0 +1,97 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	euro := WithFormatter(NewFormatter(2, ",", ".", "€", "1 $"))
+	swiss := WithFormatter(NewFormatter(2, ".", "'", "CHF", "$ 1"))
+	french := WithFormatter(NewFormatter(2, ",", " ", "€", "1 $"))
+
+	tcs := []struct {
+		input    string
+		code     string
+		opts     []Option
+		expected Amount
+	}{
+		{"$1,234.56", USD, nil, 123456},
+		{"1234.56", USD, nil, 123456},
+		{"$-12.00", USD, nil, -1200},
+		{"-$12", USD, nil, -1200},
+		{" - $ 0.5 ", USD, nil, -50},
+		{"−$1.00", USD, nil, -100},
+		{"USD 1,000,000", USD, nil, 100000000},
+		{"1.234,56 €", EUR, []Option{euro}, 123456},
+		{"-1.234,56 €", EUR, []Option{euro}, -123456},
+		{"€ 12", EUR, []Option{euro}, 1200},
+		{"CHF 1'234.50", CHF, []Option{swiss}, 123450},
+		{"1 234,56 €", EUR, []Option{french}, 123456},
+		{"¥1,234", JPY, nil, 1234},
+		{"¥1,234.00", JPY, nil, 1234},
+		{"1,234.567 .د.ك", KWD, nil, 1234567},
+		{"9,223,372,036,854,775.807", USD, []Option{WithFraction(3)}, MaxAmount},
+	}
+
+	for _, tc := range tcs {
+		m, err := Parse(tc.input, tc.code, tc.opts...)
+		if err != nil || m.Amount != tc.expected || m.Currency.Code != tc.code {
+			t.Errorf("Expected %q to parse to %d %s got %v (%v)", tc.input, tc.expected, tc.code, m, err)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tcs := []struct {
+		input string
+		err   error
+	}{
+		{"", ErrInvalidAmount},
+		{"$", ErrInvalidAmount},
+		{"12$34", ErrInvalidAmount},
+		{"€12", ErrInvalidAmount},
+		{"1,23.45", ErrInvalidAmount},
+		{"12,34,567", ErrInvalidAmount},
+		{",123", ErrInvalidAmount},
+		{"--12", ErrInvalidAmount},
+		{"+12", ErrInvalidAmount},
+		{"12.", ErrInvalidAmount},
+		{"1.2.3", ErrInvalidAmount},
+		{"$12-", ErrInvalidAmount},
+		{"$1.234", ErrPrecisionLoss},
+		{"$999,999,999,999,999,999,999", ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		_, err := Parse(tc.input, USD)
+
+		var perr *ParseError
+		if !errors.As(err, &perr) || perr.Input != tc.input || !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %q got %v", tc.err, tc.input, err)
+		}
+	}
+
+	if _, err := Parse("1", "XYZ", WithStrictCode()); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+}
+
+func TestParse_Display(t *testing.T) {
+	for code := range loadRegistry() {
+		for _, a := range []int64{0, 1, -1, 123456789, -987654321, int64(MaxAmount), int64(MinAmount)} {
+			m := New(a, code)
+			p, err := Parse(m.Display(), code)
+			if err != nil || p.Amount != m.Amount {
+				t.Errorf("Expected %q to parse to %d got %v (%v)", m.Display(), a, p, err)
+			}
+		}
+	}
+}
+
+func TestFormatter_Parse(t *testing.T) {
+	f := NewFormatter(3, ",", ".", "BD", "1 $")
+	if a, err := f.Parse("-1.234,5 BD"); err != nil || a != -1234500 {
+		t.Errorf("Expected %d got %d (%v)", -1234500, a, err)
+	}
+}
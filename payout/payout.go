@@ -0,0 +1,176 @@
+// Package payout splits marketplace charges on top of money.Money. A charge is split into
+// the payment processor fee, the platform fee, tax withholding and the seller net, which
+// always add up to the gross charge.
+//
+// Deductions are computed in the order processor fee, platform fee, withholding, each
+// rounded once. The seller net is what's left of the gross charge, so rounding never
+// makes the parts drift from it.
+package payout
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var (
+	// ErrInvalidRate happens when a Rule has a negative rate.
+	ErrInvalidRate = errors.New("payout: invalid rate")
+
+	// ErrExceedsGross happens when the deductions are higher than the gross charge.
+	ErrExceedsGross = errors.New("payout: deductions exceed gross amount")
+)
+
+// Base is what a Rule is applied to.
+type Base int
+
+const (
+	// OfGross applies a Rule to the gross charge.
+	OfGross Base = iota
+	// OfRemaining applies a Rule to what's left of the gross charge after the previous deductions.
+	OfRemaining
+)
+
+// Rule computes a deduction as Rate of its Base plus Fixed, bounded by Min and Max.
+// The zero Rule deducts nothing.
+type Rule struct {
+	// Rate is the fraction of the Base deducted, e.g. 29/1000 for 2.9%. Nil means none.
+	Rate *big.Rat
+	// Fixed is added to the rated deduction. Nil means none.
+	Fixed *money.Money
+	// Min and Max bound the deduction. Nil means unbounded.
+	Min, Max *money.Money
+	Base     Base
+}
+
+// Rules configure how Split splits a charge.
+type Rules struct {
+	ProcessorFee Rule
+	PlatformFee  Rule
+	Withholding  Rule
+	// Rounding is used by rated deductions.
+	Rounding money.RoundingMode
+}
+
+// Payout is a charge split into its parts. ProcessorFee, PlatformFee, Withholding and
+// SellerNet sum to Gross.
+type Payout struct {
+	Gross        *money.Money
+	ProcessorFee *money.Money
+	PlatformFee  *money.Money
+	Withholding  *money.Money
+	SellerNet    *money.Money
+}
+
+// Parts returns ProcessorFee, PlatformFee, Withholding and SellerNet in this order.
+func (p *Payout) Parts() []*money.Money {
+	return []*money.Money{p.ProcessorFee, p.PlatformFee, p.Withholding, p.SellerNet}
+}
+
+// Split splits the gross charge by the Rules. It returns money.ErrNilMoney,
+// money.ErrInvalidAmount for negative Money, money.ErrCurrencyMismatch for rules in
+// other currencies, ErrInvalidRate and ErrExceedsGross when the deductions would leave
+// the seller with a negative net.
+func (r Rules) Split(gross *money.Money) (*Payout, error) {
+	if err := check(gross, gross); err != nil {
+		return nil, err
+	}
+
+	p := &Payout{Gross: gross}
+	remaining := gross
+	for _, d := range []struct {
+		name string
+		rule Rule
+		part **money.Money
+	}{
+		{"processor fee", r.ProcessorFee, &p.ProcessorFee},
+		{"platform fee", r.PlatformFee, &p.PlatformFee},
+		{"withholding", r.Withholding, &p.Withholding},
+	} {
+		base := gross
+		if d.rule.Base == OfRemaining {
+			base = remaining
+		}
+
+		m, err := d.rule.apply(base, r.Rounding)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.name, err)
+		}
+
+		if m.Amount > remaining.Amount {
+			return nil, fmt.Errorf("%w: %s of %s leaves %s", ErrExceedsGross, d.name, m.Display(), remaining.Display())
+		}
+
+		*d.part = m
+		remaining, _ = remaining.Subtract(m)
+	}
+	p.SellerNet = remaining
+
+	return p, nil
+}
+
+// apply returns the deduction of the Rule from given base.
+func (r Rule) apply(base *money.Money, mode money.RoundingMode) (*money.Money, error) {
+	d := &money.Money{Currency: base.Currency}
+	if r.Rate != nil {
+		if r.Rate.Sign() < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRate, r.Rate.RatString())
+		}
+
+		if _, err := d.SetRat(new(big.Rat).Mul(base.AsRat(), r.Rate), mode); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Fixed != nil {
+		if err := check(base, r.Fixed); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if d, err = d.Add(r.Fixed); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Min != nil {
+		if err := check(base, r.Min); err != nil {
+			return nil, err
+		}
+
+		if d.Amount < r.Min.Amount {
+			d = r.Min
+		}
+	}
+
+	if r.Max != nil {
+		if err := check(base, r.Max); err != nil {
+			return nil, err
+		}
+
+		if d.Amount > r.Max.Amount {
+			d = r.Max
+		}
+	}
+
+	return d, nil
+}
+
+// check validates Money used along with given gross charge.
+func check(gross, m *money.Money) error {
+	if !m.IsValid() {
+		return money.ErrNilMoney
+	}
+
+	if _, err := gross.Compare(m); err != nil {
+		return err
+	}
+
+	if m.IsNegative() {
+		return money.ErrInvalidAmount
+	}
+
+	return nil
+}
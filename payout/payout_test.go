@@ -0,0 +1,103 @@
+package payout
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func usd(amount int64) *money.Money {
+	return money.New(amount, money.USD)
+}
+
+var rules = Rules{
+	ProcessorFee: Rule{Rate: big.NewRat(29, 1000), Fixed: usd(30)},
+	PlatformFee:  Rule{Rate: big.NewRat(1, 10), Min: usd(50), Max: usd(5000)},
+	Withholding:  Rule{Rate: big.NewRat(24, 100), Base: OfRemaining},
+}
+
+func TestRules_Split(t *testing.T) {
+	tcs := []struct {
+		gross                                 int64
+		processor, platform, withholding, net money.Amount
+	}{
+		{10000, 320, 1000, 2083, 6597},
+		{100, 33, 50, 4, 13},
+		{100000, 2930, 5000, 22097, 69973},
+		{0, 30, 50, 0, 0},
+	}
+
+	for _, tc := range tcs {
+		p, err := rules.Split(usd(tc.gross))
+		if tc.gross == 0 {
+			if !errors.Is(err, ErrExceedsGross) {
+				t.Errorf("Expected %v got %v", ErrExceedsGross, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := []money.Amount{p.ProcessorFee.Amount, p.PlatformFee.Amount, p.Withholding.Amount, p.SellerNet.Amount}
+		expected := []money.Amount{tc.processor, tc.platform, tc.withholding, tc.net}
+		for i := range got {
+			if got[i] != expected[i] {
+				t.Errorf("Expected split of %d to be %v got %v", tc.gross, expected, got)
+				break
+			}
+		}
+	}
+}
+
+func TestRules_SplitReconciles(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for n := 0; n < 1000; n++ {
+		gross := usd(100 + r.Int63n(1_000_000))
+		p, err := rules.Split(gross)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sum := usd(0)
+		for _, m := range p.Parts() {
+			if m.IsNegative() {
+				t.Errorf("Expected non-negative parts of %v got %v", gross, p.Parts())
+			}
+			sum, _ = sum.Add(m)
+		}
+
+		if !sum.Equal(gross) {
+			t.Errorf("Expected parts to sum to %v got %v", gross, sum)
+		}
+	}
+}
+
+func TestRules_SplitErrors(t *testing.T) {
+	tcs := []struct {
+		rules Rules
+		gross *money.Money
+		err   error
+	}{
+		{Rules{}, nil, money.ErrNilMoney},
+		{Rules{}, usd(-1), money.ErrInvalidAmount},
+		{Rules{PlatformFee: Rule{Rate: big.NewRat(-1, 10)}}, usd(100), ErrInvalidRate},
+		{Rules{PlatformFee: Rule{Fixed: money.New(1, money.EUR)}}, usd(100), money.ErrCurrencyMismatch},
+		{Rules{PlatformFee: Rule{Rate: big.NewRat(11, 10)}}, usd(100), ErrExceedsGross},
+	}
+
+	for _, tc := range tcs {
+		if _, err := tc.rules.Split(tc.gross); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v got %v", tc.err, err)
+		}
+	}
+
+	if p, err := (Rules{}).Split(usd(100)); err != nil || p.SellerNet.Amount != 100 {
+		t.Errorf("Expected seller net of %d got %v (%v)", 100, p, err)
+	}
+}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+)
+
+// Interpolation defines how a percentile falling between two Money values is computed.
+type Interpolation int
+
+// Interpolation methods supported by Percentile.
+const (
+	// InterpolateLinear interpolates linearly between the two neighbours.
+	InterpolateLinear Interpolation = iota
+	// InterpolateLower takes the lower neighbour.
+	InterpolateLower
+	// InterpolateHigher takes the higher neighbour.
+	InterpolateHigher
+	// InterpolateNearest takes the nearest neighbour, the even index on ties.
+	InterpolateNearest
+	// InterpolateMidpoint takes the mean of the two neighbours.
+	InterpolateMidpoint
+)
+
+// Median returns new Money struct with the median value of given Money.
+// For an even count of values the mean of the two middle values is rounded with given rounding mode.
+// Nil values are skipped.
+func Median(ms []*Money, mode RoundingMode) (*Money, error) {
+	return Percentile(ms, 50, InterpolateMidpoint, mode)
+}
+
+// Percentile returns new Money struct with the p-th percentile (0 <= p <= 100) of given Money.
+// The rank of the percentile is p/100 * (n-1) over sorted values; when it falls between two
+// values the result is computed with given interpolation and rounded to minor units with
+// given rounding mode. Nil values are skipped.
+func Percentile(ms []*Money, p float64, interp Interpolation, mode RoundingMode) (*Money, error) {
+	if math.IsNaN(p) || p < 0 || p > 100 {
+		return nil, fmt.Errorf("%w: percentile must be between 0 and 100", ErrInvalidRatio)
+	}
+
+	first, err := firstOfCurrency(ms)
+	if err != nil {
+		return nil, err
+	}
+
+	as := make([]Amount, 0, len(ms))
+	for _, m := range ms {
+		if m != nil {
+			as = append(as, m.Amount)
+		}
+	}
+	slices.Sort(as)
+
+	// rank = p * (n-1) / 100, kept as an exact fraction.
+	rank := new(big.Rat).SetFloat64(p)
+	rank.Mul(rank, big.NewRat(int64(len(as)-1), 100))
+	lo := new(big.Int).Quo(rank.Num(), rank.Denom()).Int64()
+	frac := new(big.Rat).Sub(rank, new(big.Rat).SetInt64(lo))
+
+	if frac.Sign() == 0 {
+		return &Money{Amount: as[lo], Currency: first.Currency}, nil
+	}
+
+	a, b := big.NewInt(int64(as[lo])), big.NewInt(int64(as[lo+1]))
+	var v *big.Int
+
+	switch interp {
+	case InterpolateLower:
+		v = a
+	case InterpolateHigher:
+		v = b
+	case InterpolateNearest:
+		half := frac.Cmp(big.NewRat(1, 2))
+		if half > 0 || (half == 0 && lo%2 == 1) {
+			v = b
+		} else {
+			v = a
+		}
+	case InterpolateMidpoint:
+		v = roundQuo(new(big.Int).Add(a, b), big.NewInt(2), mode)
+	default:
+		// a + frac * (b - a)
+		d := new(big.Rat).SetInt(new(big.Int).Sub(b, a))
+		d.Mul(d, frac)
+		d.Add(d, new(big.Rat).SetInt(a))
+		v = roundQuo(d.Num(), d.Denom(), mode)
+	}
+
+	return &Money{Amount: Amount(v.Int64()), Currency: first.Currency}, nil
+}
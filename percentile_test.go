@@ -0,0 +1,79 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func moneys(code string, amounts ...int64) []*Money {
+	ms := make([]*Money, 0, len(amounts))
+	for _, a := range amounts {
+		ms = append(ms, New(a, code))
+	}
+
+	return ms
+}
+
+func TestMedian(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		mode     RoundingMode
+		expected Amount
+	}{
+		{[]int64{300, 100, 200}, RoundHalfUp, 200},
+		{[]int64{400, 100, 200, 300}, RoundHalfUp, 250},
+		{[]int64{1, 2}, RoundHalfUp, 2},
+		{[]int64{1, 2}, RoundHalfEven, 2},
+		{[]int64{1, 2}, RoundDown, 1},
+		{[]int64{-1, -2}, RoundHalfUp, -2},
+		{[]int64{42}, RoundHalfUp, 42},
+	}
+
+	for _, tc := range tcs {
+		m, err := Median(moneys(EUR, tc.amounts...), tc.mode)
+
+		if err != nil || m.Amount != tc.expected {
+			t.Errorf("Expected median of %v to be %d got %v (%v)", tc.amounts, tc.expected, m, err)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	ms := moneys(EUR, 100, 200, 300, 400, 500)
+	tcs := []struct {
+		p        float64
+		interp   Interpolation
+		expected Amount
+	}{
+		{0, InterpolateLinear, 100},
+		{100, InterpolateLinear, 500},
+		{50, InterpolateLinear, 300},
+		{90, InterpolateLinear, 460},
+		{90, InterpolateLower, 400},
+		{90, InterpolateHigher, 500},
+		{90, InterpolateNearest, 500},
+		{85, InterpolateNearest, 400},
+		{90, InterpolateMidpoint, 450},
+		{33.3, InterpolateLinear, 233},
+	}
+
+	for _, tc := range tcs {
+		m, err := Percentile(ms, tc.p, tc.interp, RoundHalfUp)
+
+		if err != nil || m.Amount != tc.expected {
+			t.Errorf("Expected p%v (%d) to be %d got %v (%v)", tc.p, tc.interp, tc.expected, m, err)
+		}
+	}
+
+	if _, err := Percentile(ms, 101, InterpolateLinear, RoundHalfUp); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := Percentile(nil, 50, InterpolateLinear, RoundHalfUp); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+
+	if _, err := Median(append(ms, New(1, USD)), RoundHalfUp); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
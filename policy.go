@@ -0,0 +1,55 @@
+package money
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// Policy defines how invalid operations are handled: operations on nil Money, on Money
+// without Currency or of different currencies, and arithmetic overflowing Amount.
+type Policy int32
+
+const (
+	// PolicyError returns errors from operations which can return them. It is the default.
+	PolicyError Policy = iota
+	// PolicyPanic panics with the error instead, e.g. to fail fast during development.
+	PolicyPanic
+	// PolicySaturate clamps overflowing results to the limits of Amount instead of failing,
+	// and returns errors for other invalid operations like PolicyError.
+	PolicySaturate
+)
+
+var policy atomic.Int32
+
+// SetPolicy sets the Policy used by all operations. It is safe to call concurrently
+// with operations, but is meant to be called once during program initialization.
+func SetPolicy(p Policy) {
+	policy.Store(int32(p))
+}
+
+// GetPolicy returns the Policy used by all operations.
+func GetPolicy() Policy {
+	return Policy(policy.Load())
+}
+
+// saturation returns the limit of Amount an overflowing result would be clamped to.
+func saturation(positive bool) Amount {
+	if positive {
+		return math.MaxInt64
+	}
+
+	return math.MinInt64
+}
+
+// overflowed handles an overflowing result according to the active Policy, sat is
+// the saturated result.
+func overflowed(sat Amount) (Amount, error) {
+	switch GetPolicy() {
+	case PolicyPanic:
+		panic(ErrOverflow)
+	case PolicySaturate:
+		return sat, nil
+	}
+
+	return 0, ErrOverflow
+}
@@ -0,0 +1,64 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPolicy_Overflow(t *testing.T) {
+	defer SetPolicy(PolicyError)
+
+	max, min := New(math.MaxInt64, EUR), New(math.MinInt64, EUR)
+
+	if _, err := max.Add(New(1, EUR)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := min.Subtract(New(1, EUR)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	SetPolicy(PolicySaturate)
+
+	tcs := []struct {
+		r        func() (*Money, error)
+		expected Amount
+	}{
+		{func() (*Money, error) { return max.Add(New(1, EUR)) }, math.MaxInt64},
+		{func() (*Money, error) { return min.Add(New(-1, EUR)) }, math.MinInt64},
+		{func() (*Money, error) { return min.Subtract(New(1, EUR)) }, math.MinInt64},
+		{func() (*Money, error) { return max.Subtract(New(-1, EUR)) }, math.MaxInt64},
+		{func() (*Money, error) { return max.Multiply(2), nil }, math.MaxInt64},
+		{func() (*Money, error) { return max.Multiply(-2), nil }, math.MinInt64},
+		{func() (*Money, error) { return min.Multiply(-1), nil }, math.MaxInt64},
+		{func() (*Money, error) { return New(3, EUR).Multiply(-2), nil }, -6},
+	}
+
+	for i, tc := range tcs {
+		if m, err := tc.r(); err != nil || m.Amount != tc.expected {
+			t.Errorf("Expected %d for case %d got %v (%v)", tc.expected, i, m, err)
+		}
+	}
+}
+
+func TestPolicy_Panic(t *testing.T) {
+	defer SetPolicy(PolicyError)
+	SetPolicy(PolicyPanic)
+
+	for _, f := range []func(){
+		func() { _, _ = New(math.MaxInt64, EUR).Add(New(1, EUR)) },
+		func() { _ = New(math.MaxInt64, EUR).Multiply(2) },
+		func() { _, _ = New(1, EUR).Add(New(1, USD)) },
+		func() { _, _ = New(1, EUR).Add(nil) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Expected a panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
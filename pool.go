@@ -0,0 +1,38 @@
+package money
+
+import "sync"
+
+// Pool reuses Money structs for batch jobs creating and discarding large numbers of
+// Money, reducing allocations and GC pressure. Money obtained from a Pool must not
+// be used after it has been put back. Pool is safe for concurrent use.
+type Pool struct {
+	p sync.Pool
+}
+
+// NewPool creates new empty Pool.
+func NewPool() *Pool {
+	return &Pool{p: sync.Pool{New: func() any { return new(Money) }}}
+}
+
+// New returns Money from the Pool set to given amount and currency code, like New.
+func (p *Pool) New(amount int64, code string) *Money {
+	c, err := resolveCode(code)
+	if err != nil {
+		panic(err)
+	}
+
+	m := p.p.Get().(*Money)
+	m.Amount, m.Currency = Amount(amount), c
+
+	return m
+}
+
+// Put puts Money back into the Pool for reuse. Nil Money is ignored.
+func (p *Pool) Put(m *Money) {
+	if m == nil {
+		return
+	}
+
+	*m = Money{}
+	p.p.Put(m)
+}
@@ -0,0 +1,28 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestPool(t *testing.T) {
+	p := NewPool()
+
+	m := p.New(1099, "eur")
+	if !m.Equal(New(1099, EUR)) || *m.Currency != *GetCurrency(EUR) {
+		t.Errorf("Expected %v got %v", New(1099, EUR), m)
+	}
+
+	p.Put(m)
+	p.Put(nil)
+
+	if m := p.New(5, "XYZ"); !m.Equal(New(5, "XYZ")) || m.Display() != New(5, "XYZ").Display() {
+		t.Errorf("Expected %v got %v", New(5, "XYZ"), m)
+	}
+}
+
+func BenchmarkPool_New(b *testing.B) {
+	p := NewPool()
+	for i := 0; i < b.N; i++ {
+		p.Put(p.New(int64(i), EUR))
+	}
+}
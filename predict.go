@@ -0,0 +1,49 @@
+package money
+
+import "math"
+
+// Limits of Amount, the range every Money value lies in.
+const (
+	MaxAmount Amount = math.MaxInt64
+	MinAmount Amount = math.MinInt64
+)
+
+// MaxMajorUnits returns the largest number of whole major units Money of the Currency
+// can hold, e.g. 92233720368547758 for currencies with 2 decimals. The smallest one is
+// its negation.
+func (c *Currency) MaxMajorUnits() int64 {
+	p, ok := pow10(c.get().Fraction)
+	if !ok {
+		return 0
+	}
+
+	return int64(MaxAmount) / p
+}
+
+// CanAdd reports whether Money can be added to the other without an error,
+// that is both have the same Currency and the sum fits into Amount.
+func (m *Money) CanAdd(om *Money) bool {
+	if !m.SameCurrency(om) {
+		return false
+	}
+
+	_, ok := calcAdd(m.Amount, om.Amount)
+	return ok
+}
+
+// CanSubtract reports whether the other Money can be subtracted from Money without an error,
+// that is both have the same Currency and the difference fits into Amount.
+func (m *Money) CanSubtract(om *Money) bool {
+	if !m.SameCurrency(om) {
+		return false
+	}
+
+	_, ok := calcSubtract(m.Amount, om.Amount)
+	return ok
+}
+
+// CanMultiply reports whether Money can be multiplied by given multiplier without overflowing Amount.
+func (m *Money) CanMultiply(mul int64) bool {
+	_, ok := calcMultiply(m.Amount, mul)
+	return ok
+}
@@ -0,0 +1,70 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCurrency_MaxMajorUnits(t *testing.T) {
+	tcs := []struct {
+		code     string
+		expected int64
+	}{
+		{JPY, math.MaxInt64},
+		{EUR, 92233720368547758},
+		{KWD, 9223372036854775},
+		{CLF, 922337203685477},
+	}
+
+	for _, tc := range tcs {
+		if r := GetCurrency(tc.code).MaxMajorUnits(); r != tc.expected {
+			t.Errorf("Expected %d for %s got %d", tc.expected, tc.code, r)
+		}
+	}
+}
+
+func TestMoney_CanAdd(t *testing.T) {
+	tcs := []struct {
+		m, om    *Money
+		add, sub bool
+	}{
+		{New(1, EUR), New(2, EUR), true, true},
+		{New(math.MaxInt64, EUR), New(1, EUR), false, true},
+		{New(math.MinInt64, EUR), New(1, EUR), true, false},
+		{New(math.MinInt64, EUR), New(-1, EUR), false, true},
+		{New(1, EUR), New(1, USD), false, false},
+		{New(1, EUR), nil, false, false},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.m.CanAdd(tc.om); r != tc.add {
+			t.Errorf("Expected CanAdd of %v and %v to be %t got %t", tc.m, tc.om, tc.add, r)
+		}
+
+		if r := tc.m.CanSubtract(tc.om); r != tc.sub {
+			t.Errorf("Expected CanSubtract of %v and %v to be %t got %t", tc.m, tc.om, tc.sub, r)
+		}
+	}
+}
+
+func TestMoney_CanMultiply(t *testing.T) {
+	tcs := []struct {
+		amount   Amount
+		mul      int64
+		expected bool
+	}{
+		{100, 3, true},
+		{0, math.MaxInt64, true},
+		{MaxAmount / 2, 2, true},
+		{MaxAmount/2 + 1, 2, false},
+		{MinAmount, -1, false},
+		{-1, math.MinInt64, false},
+		{MinAmount, 1, true},
+	}
+
+	for _, tc := range tcs {
+		if r := New(int64(tc.amount), EUR).CanMultiply(tc.mul); r != tc.expected {
+			t.Errorf("Expected CanMultiply of %d by %d to be %t got %t", tc.amount, tc.mul, tc.expected, r)
+		}
+	}
+}
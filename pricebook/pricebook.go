@@ -0,0 +1,138 @@
+// Package pricebook derives per-currency display prices from a base price. The base price
+// is converted with the rates of a money.RateProvider and then adjusted by the rules of
+// the target currency, e.g. charm pricing ending in .99 or rounding to whole units.
+package pricebook
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// ErrInvalidStep happens when a Rule is created with a step below one or an ending
+// outside of the step.
+var ErrInvalidStep = errors.New("pricebook: invalid rounding step")
+
+// Rule adjusts a converted price.
+type Rule func(price *money.Money) (*money.Money, error)
+
+// RoundTo rounds prices to multiples of step minor units with given mode, e.g.
+// RoundTo(500, money.RoundHalfUp) rounds dollar prices to the nearest 5 dollars.
+func RoundTo(step money.Amount, mode money.RoundingMode) Rule {
+	return Charm(step, 0, mode)
+}
+
+// WholeUnits rounds prices to whole major units of their currency with given mode.
+func WholeUnits(mode money.RoundingMode) Rule {
+	return func(price *money.Money) (*money.Money, error) {
+		unit := new(big.Rat).Inv((&money.Money{Amount: 1, Currency: price.Currency}).AsRat())
+		return snap(price, money.Amount(unit.Num().Int64()), 0, mode)
+	}
+}
+
+// Charm moves prices to the amounts ending in ending within every step minor units with
+// given mode, e.g. Charm(100, 99, money.RoundCeiling) turns 12.20 into 12.99 and
+// Charm(1000, 980, money.RoundCeiling) turns 1234 yen into 1980 yen.
+func Charm(step, ending money.Amount, mode money.RoundingMode) Rule {
+	return func(price *money.Money) (*money.Money, error) {
+		return snap(price, step, ending, mode)
+	}
+}
+
+// snap returns the price moved to step * q + ending, q rounded with given mode.
+func snap(price *money.Money, step, ending money.Amount, mode money.RoundingMode) (*money.Money, error) {
+	if step < 1 || ending < 0 || ending >= step {
+		return nil, fmt.Errorf("%w: %d ending in %d", ErrInvalidStep, step, ending)
+	}
+
+	// SetRat rounds to minor units, so q is given in major units to round it to an integer.
+	unit := (&money.Money{Amount: 1, Currency: price.Currency}).AsRat()
+	q := big.NewRat(int64(price.Amount)-int64(ending), int64(step))
+	m, err := (&money.Money{Currency: price.Currency}).SetRat(q.Mul(q, unit), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.CanMultiply(int64(step)) {
+		return nil, money.ErrOverflow
+	}
+
+	return m.Multiply(int64(step)).Add(&money.Money{Amount: ending, Currency: price.Currency})
+}
+
+// PriceBook converts base prices into display prices of many currencies. PriceBook is
+// safe for concurrent use.
+type PriceBook struct {
+	rates    money.RateProvider
+	rounding money.RoundingMode
+
+	mu    sync.RWMutex
+	rules map[string][]Rule
+}
+
+// New creates new PriceBook converting prices with the rates of the RateProvider,
+// rounded with given mode.
+func New(rates money.RateProvider, mode money.RoundingMode) *PriceBook {
+	return &PriceBook{rates: rates, rounding: mode, rules: make(map[string][]Rule)}
+}
+
+// SetRules sets the rules applied, in the order given, to prices of given currency.
+// No rules removes the rules of the currency.
+func (b *PriceBook) SetRules(code string, rules ...Rule) {
+	code = canonical(code)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(rules) == 0 {
+		delete(b.rules, code)
+		return
+	}
+
+	b.rules[code] = rules
+}
+
+// Price returns the display price of the base price in given currency. It returns the
+// errors of money.Convert and of the rules.
+func (b *PriceBook) Price(base *money.Money, code string) (*money.Money, error) {
+	p, _, err := money.Convert(base, code, b.rates, b.rounding)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.RLock()
+	rules := b.rules[canonical(code)]
+	b.mu.RUnlock()
+
+	for _, r := range rules {
+		if p, err = r(p); err != nil {
+			return nil, fmt.Errorf("pricing %s: %w", code, err)
+		}
+	}
+
+	return p, nil
+}
+
+// Prices returns the display prices of the base price in given currencies, in the
+// order given.
+func (b *PriceBook) Prices(base *money.Money, codes ...string) ([]*money.Money, error) {
+	ps := make([]*money.Money, 0, len(codes))
+	for _, code := range codes {
+		p, err := b.Price(base, code)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+
+	return ps, nil
+}
+
+// canonical returns the currency code the way the money package keys currencies.
+func canonical(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,79 @@
+package pricebook
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func TestRules(t *testing.T) {
+	tcs := []struct {
+		rule     Rule
+		price    *money.Money
+		expected money.Amount
+	}{
+		{Charm(100, 99, money.RoundCeiling), money.New(1220, money.USD), 1299},
+		{Charm(100, 99, money.RoundHalfUp), money.New(1220, money.USD), 1199},
+		{Charm(100, 99, money.RoundCeiling), money.New(1299, money.USD), 1299},
+		{Charm(1000, 980, money.RoundCeiling), money.New(1234, money.JPY), 1980},
+		{RoundTo(500, money.RoundHalfUp), money.New(1249, money.USD), 1000},
+		{RoundTo(500, money.RoundHalfUp), money.New(1250, money.USD), 1500},
+		{WholeUnits(money.RoundCeiling), money.New(1201, money.USD), 1300},
+		{WholeUnits(money.RoundHalfEven), money.New(1250, money.JPY), 1250},
+		{WholeUnits(money.RoundHalfEven), money.New(12500, money.BHD), 12000},
+	}
+
+	for _, tc := range tcs {
+		p, err := tc.rule(tc.price)
+		if err != nil || p.Amount != tc.expected || !p.SameCurrency(tc.price) {
+			t.Errorf("Expected %d for %d got %v (%v)", tc.expected, tc.price.Amount, p, err)
+		}
+	}
+
+	for _, r := range []Rule{RoundTo(0, money.RoundHalfUp), Charm(100, 100, money.RoundHalfUp), Charm(100, -1, money.RoundHalfUp)} {
+		if _, err := r(money.New(1, money.USD)); !errors.Is(err, ErrInvalidStep) {
+			t.Errorf("Expected %v got %v", ErrInvalidStep, err)
+		}
+	}
+}
+
+func TestPriceBook_Prices(t *testing.T) {
+	b := New(money.StaticRates{money.EUR: {
+		money.USD: big.NewRat(108, 100),
+		money.JPY: big.NewRat(1625, 10),
+		money.GBP: big.NewRat(85, 100),
+	}}, money.RoundHalfEven)
+
+	b.SetRules(money.USD, Charm(100, 99, money.RoundCeiling))
+	b.SetRules("jpy", RoundTo(100, money.RoundHalfUp))
+	b.SetRules(money.GBP, WholeUnits(money.RoundCeiling), Charm(100, 99, money.RoundFloor))
+
+	ps, err := b.Prices(money.New(1999, money.EUR), money.EUR, money.USD, money.JPY, money.GBP)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*money.Money{
+		money.New(1999, money.EUR),
+		money.New(2199, money.USD),
+		money.New(3200, money.JPY),
+		money.New(1699, money.GBP),
+	}
+
+	for i, e := range expected {
+		if !ps[i].Equal(e) {
+			t.Errorf("Expected %s got %s", e.Display(), ps[i].Display())
+		}
+	}
+
+	b.SetRules(money.USD)
+	if p, _ := b.Price(money.New(1999, money.EUR), money.USD); p.Amount != 2159 {
+		t.Errorf("Expected %d without rules got %d", 2159, p.Amount)
+	}
+
+	if _, err := b.Prices(money.New(1999, money.EUR), money.CHF); !errors.Is(err, money.ErrNoRate) {
+		t.Errorf("Expected %v got %v", money.ErrNoRate, err)
+	}
+}
@@ -0,0 +1,138 @@
+// Package proration prorates subscription plan changes on top of money.Money. When a
+// plan changes within a billing period, the unused time on the old plan is credited and
+// the remaining time on the new plan is charged.
+//
+// Time is counted in calendar days of the location of the period start, so periods
+// spanning daylight saving changes or months of different lengths prorate by the days a
+// customer sees on the calendar rather than by elapsed hours. The day of the change is
+// charged on the new plan.
+package proration
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var (
+	// ErrInvalidPeriod happens when a Period doesn't end at least a day after its start.
+	ErrInvalidPeriod = errors.New("proration: period must span at least one day")
+
+	// ErrOutsidePeriod happens when a plan changes outside of the billing Period.
+	ErrOutsidePeriod = errors.New("proration: change is outside of the period")
+)
+
+// Period is a billing period from Start until End, End being the start of the next period.
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Days returns the number of calendar days of the Period.
+func (p Period) Days() int {
+	return days(p.Start, p.End, p.Start.Location())
+}
+
+// Plan is a subscription plan and its price for a whole billing period.
+type Plan struct {
+	Name  string
+	Price *money.Money
+}
+
+// Adjustment is an itemized credit or charge for a part of a Period on a Plan.
+// Credits are negative, charges positive.
+type Adjustment struct {
+	Description string
+	Plan        string
+	From        time.Time
+	To          time.Time
+	// Days is the number of calendar days from From until To.
+	Days   int
+	Amount *money.Money
+}
+
+// Prorate returns the adjustments of changing from the current to the next Plan at given time
+// within the Period: the credit of the unused days on the current Plan followed by the charge
+// of the remaining days on the next Plan. Each Adjustment is the price of its Plan times
+// the remaining days over the days of the Period, rounded with given mode.
+// It returns ErrInvalidPeriod, ErrOutsidePeriod, money.ErrNilMoney for plans without
+// price and money.ErrCurrencyMismatch when the plans are priced in different currencies.
+func Prorate(p Period, current, next Plan, at time.Time, mode money.RoundingMode) ([]Adjustment, error) {
+	loc := p.Start.Location()
+	total := p.Days()
+	if total < 1 {
+		return nil, ErrInvalidPeriod
+	}
+
+	if at.Before(p.Start) || !at.Before(p.End) {
+		return nil, fmt.Errorf("%w: %s not in [%s, %s)", ErrOutsidePeriod, at.Format(time.DateOnly), p.Start.Format(time.DateOnly), p.End.Format(time.DateOnly))
+	}
+
+	if !current.Price.IsValid() || !next.Price.IsValid() {
+		return nil, money.ErrNilMoney
+	}
+
+	if _, err := current.Price.Compare(next.Price); err != nil {
+		return nil, err
+	}
+
+	remaining := days(at, p.End, loc)
+	from := date(at, loc)
+
+	credit, err := prorate(current.Price, remaining, total, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	charge, err := prorate(next.Price, remaining, total, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Adjustment{
+		{Description: "Unused time on " + current.Name, Plan: current.Name, From: from, To: p.End, Days: remaining, Amount: credit.Negative()},
+		{Description: "Remaining time on " + next.Name, Plan: next.Name, From: from, To: p.End, Days: remaining, Amount: charge},
+	}, nil
+}
+
+// Net returns the sum of the adjustments, what's owed when positive and what's credited
+// when negative. It returns money.ErrNoValues for no adjustments.
+func Net(as []Adjustment) (*money.Money, error) {
+	if len(as) == 0 {
+		return nil, money.ErrNoValues
+	}
+
+	net := as[0].Amount
+	for _, a := range as[1:] {
+		var err error
+		if net, err = net.Add(a.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return net, nil
+}
+
+// prorate returns price times n over d rounded with given mode.
+func prorate(price *money.Money, n, d int, mode money.RoundingMode) (*money.Money, error) {
+	r := new(big.Rat).Mul(price.AsRat(), big.NewRat(int64(n), int64(d)))
+	return (&money.Money{Currency: price.Currency}).SetRat(r, mode)
+}
+
+// date returns the midnight of the calendar day of t in given location.
+func date(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// days returns the number of calendar days from a until b in given location. Days are
+// counted on UTC dates, which are always 24 hours long.
+func days(a, b time.Time, loc *time.Location) int {
+	ay, am, ad := a.In(loc).Date()
+	by, bm, bd := b.In(loc).Date()
+
+	return int(time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+}
@@ -0,0 +1,104 @@
+package proration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func TestProrate(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	basic := Plan{Name: "basic", Price: money.New(3100, money.USD)}
+	pro := Plan{Name: "pro", Price: money.New(6200, money.USD)}
+
+	tcs := []struct {
+		period              Period
+		at                  time.Time
+		days                int
+		credit, charge, net money.Amount
+	}{
+		{
+			Period{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+			time.Date(2026, 1, 11, 10, 0, 0, 0, time.UTC),
+			21, -2100, 4200, 2100,
+		},
+		{
+			// Daylight saving starts on March 8th, the period is still 31 days long.
+			Period{time.Date(2026, 3, 1, 0, 0, 0, 0, ny), time.Date(2026, 4, 1, 0, 0, 0, 0, ny)},
+			time.Date(2026, 3, 10, 12, 0, 0, 0, ny),
+			22, -2200, 4400, 2200,
+		},
+		{
+			// The change is still on March 9th in New York.
+			Period{time.Date(2026, 3, 1, 0, 0, 0, 0, ny), time.Date(2026, 4, 1, 0, 0, 0, 0, ny)},
+			time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC),
+			23, -2300, 4600, 2300,
+		},
+		{
+			Period{time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+			time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			28, -3100, 6200, 3100,
+		},
+	}
+
+	for _, tc := range tcs {
+		as, err := Prorate(tc.period, basic, pro, tc.at, money.RoundHalfEven)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		net, _ := Net(as)
+		if as[0].Days != tc.days || as[0].Amount.Amount != tc.credit || as[1].Amount.Amount != tc.charge || net.Amount != tc.net {
+			t.Errorf("Expected %d days, credit %d, charge %d and net %d at %s got %d days, %v, %v and %v",
+				tc.days, tc.credit, tc.charge, tc.net, tc.at, as[0].Days, as[0].Amount.Amount, as[1].Amount.Amount, net.Amount)
+		}
+	}
+}
+
+func TestProrate_Rounding(t *testing.T) {
+	p := Period{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)}
+	plan := Plan{Name: "a", Price: money.New(100, money.EUR)}
+
+	as, err := Prorate(p, plan, plan, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), money.RoundHalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if as[0].Amount.Amount != -67 || as[1].Amount.Amount != 67 {
+		t.Errorf("Expected %d and %d got %d and %d", -67, 67, as[0].Amount.Amount, as[1].Amount.Amount)
+	}
+}
+
+func TestProrate_Errors(t *testing.T) {
+	jan := Period{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)}
+	eur := Plan{Price: money.New(100, money.EUR)}
+
+	tcs := []struct {
+		period Period
+		a, b   Plan
+		at     time.Time
+		err    error
+	}{
+		{Period{jan.Start, jan.Start.Add(time.Hour)}, eur, eur, jan.Start, ErrInvalidPeriod},
+		{jan, eur, eur, jan.End, ErrOutsidePeriod},
+		{jan, eur, eur, jan.Start.Add(-time.Second), ErrOutsidePeriod},
+		{jan, eur, Plan{}, jan.Start, money.ErrNilMoney},
+		{jan, eur, Plan{Price: money.New(100, money.USD)}, jan.Start, money.ErrCurrencyMismatch},
+	}
+
+	for _, tc := range tcs {
+		if _, err := Prorate(tc.period, tc.a, tc.b, tc.at, money.RoundHalfUp); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v got %v", tc.err, err)
+		}
+	}
+
+	if _, err := Net(nil); !errors.Is(err, money.ErrNoValues) {
+		t.Errorf("Expected %v got %v", money.ErrNoValues, err)
+	}
+}
@@ -0,0 +1,114 @@
+package money
+
+import "strings"
+
+// payPalCurrencies lists currencies supported by the PayPal REST APIs with the
+// number of decimals PayPal accepts for each of them.
+var payPalCurrencies = map[string]int{
+	AUD: 2, BRL: 2, CAD: 2, CHF: 2, CNY: 2, CZK: 2, DKK: 2, EUR: 2,
+	GBP: 2, HKD: 2, HUF: 0, ILS: 2, JPY: 0, MXN: 2, MYR: 2, NOK: 2,
+	NZD: 2, PHP: 2, PLN: 2, SEK: 2, SGD: 2, THB: 2, TWD: 0, USD: 2,
+}
+
+// adyenExponents lists currencies for which Adyen uses a number of minor units
+// different from the ISO 4217 one. Any other currency uses its ISO fraction.
+var adyenExponents = map[string]int{
+	CLP: 2, CVE: 0, DJF: 0, GNF: 0, IDR: 0, KMF: 0, PYG: 0, RWF: 0,
+	UGX: 0, VUV: 0, XAF: 0, XOF: 0, XPF: 0,
+	BHD: 3, IQD: 3, JOD: 3, KWD: 3, LYD: 3, OMR: 3, TND: 3,
+}
+
+// PayPalAmount represents the amount object used by the PayPal REST APIs,
+// e.g. {"currency_code": "USD", "value": "10.99"}.
+type PayPalAmount struct {
+	CurrencyCode string `json:"currency_code"`
+	Value        string `json:"value"`
+}
+
+// AdyenAmount represents the amount object used by the Adyen APIs,
+// e.g. {"currency": "EUR", "value": 1099}. Value is expressed in Adyen minor units.
+type AdyenAmount struct {
+	Currency string `json:"currency"`
+	Value    int64  `json:"value"`
+}
+
+// ToPayPal converts Money into PayPal amount object.
+// It returns ErrUnsupportedCurrency for currencies PayPal doesn't accept and
+// ErrPrecisionLoss when the amount has more decimals than PayPal allows for the currency.
+func (m *Money) ToPayPal() (*PayPalAmount, error) {
+	c := m.Currency.get()
+	d, ok := payPalCurrencies[c.Code]
+	if !ok {
+		return nil, &UnsupportedCurrencyError{Code: c.Code}
+	}
+
+	a, err := rescaleAmount(m.Amount, c.Fraction, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PayPalAmount{CurrencyCode: c.Code, Value: formatDecimal(a, d)}, nil
+}
+
+// NewFromPayPal creates and returns new instance of Money from PayPal amount object.
+func NewFromPayPal(pa PayPalAmount) (*Money, error) {
+	code := strings.ToUpper(pa.CurrencyCode)
+	d, ok := payPalCurrencies[code]
+	if !ok {
+		return nil, &UnsupportedCurrencyError{Code: code}
+	}
+
+	a, err := parseDecimal(pa.Value, d)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := NewWithOptions(0, code)
+	if err != nil {
+		return nil, err
+	}
+	if m.Amount, err = rescaleAmount(a, d, m.Currency.Fraction); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ToAdyen converts Money into Adyen amount object.
+// It returns ErrPrecisionLoss when the amount has more decimals than Adyen allows for the currency.
+func (m *Money) ToAdyen() (*AdyenAmount, error) {
+	c := m.Currency.get()
+	e := adyenExponent(c)
+
+	a, err := rescaleAmount(m.Amount, c.Fraction, e)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdyenAmount{Currency: c.Code, Value: int64(a)}, nil
+}
+
+// NewFromAdyen creates and returns new instance of Money from Adyen amount object.
+func NewFromAdyen(aa AdyenAmount) (*Money, error) {
+	m, err := NewWithOptions(0, aa.Currency)
+	if err != nil {
+		return nil, err
+	}
+	c := m.Currency.get()
+
+	a, err := rescaleAmount(Amount(aa.Value), adyenExponent(c), c.Fraction)
+	if err != nil {
+		return nil, err
+	}
+	m.Amount = a
+
+	return m, nil
+}
+
+func adyenExponent(c *Currency) int {
+	if e, ok := adyenExponents[c.Code]; ok {
+		return e
+	}
+
+	return c.Fraction
+}
@@ -0,0 +1,110 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoney_ToPayPal(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+		err      error
+	}{
+		{1099, USD, "10.99", nil},
+		{-5, EUR, "-0.05", nil},
+		{0, GBP, "0.00", nil},
+		{1500, JPY, "1500", nil},
+		{150000, HUF, "1500", nil},
+		{150050, HUF, "", ErrPrecisionLoss},
+		{100, KWD, "", ErrUnsupportedCurrency},
+	}
+
+	for _, tc := range tcs {
+		pa, err := New(tc.amount, tc.code).ToPayPal()
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v got %v", tc.err, err)
+			continue
+		}
+
+		if err == nil && (pa.Value != tc.expected || pa.CurrencyCode != tc.code) {
+			t.Errorf("Expected %s %s got %s %s", tc.expected, tc.code, pa.Value, pa.CurrencyCode)
+		}
+	}
+}
+
+func TestNewFromPayPal(t *testing.T) {
+	tcs := []struct {
+		amount   PayPalAmount
+		expected Amount
+		err      error
+	}{
+		{PayPalAmount{CurrencyCode: USD, Value: "10.99"}, 1099, nil},
+		{PayPalAmount{CurrencyCode: "usd", Value: "10"}, 1000, nil},
+		{PayPalAmount{CurrencyCode: USD, Value: "-0.5"}, -50, nil},
+		{PayPalAmount{CurrencyCode: HUF, Value: "1500"}, 150000, nil},
+		{PayPalAmount{CurrencyCode: JPY, Value: "1500.5"}, 0, ErrPrecisionLoss},
+		{PayPalAmount{CurrencyCode: USD, Value: "10.999"}, 0, ErrPrecisionLoss},
+		{PayPalAmount{CurrencyCode: USD, Value: "1,000.00"}, 0, ErrInvalidAmount},
+		{PayPalAmount{CurrencyCode: USD, Value: ""}, 0, ErrInvalidAmount},
+		{PayPalAmount{CurrencyCode: KWD, Value: "1.000"}, 0, ErrUnsupportedCurrency},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromPayPal(tc.amount)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %+v got %v", tc.err, tc.amount, err)
+			continue
+		}
+
+		if err == nil && m.Amount != tc.expected {
+			t.Errorf("Expected %d got %d", tc.expected, m.Amount)
+		}
+	}
+}
+
+func TestMoney_ToAdyen(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected int64
+		err      error
+	}{
+		{1099, EUR, 1099, nil},
+		{1500, JPY, 1500, nil},
+		{1500, CLP, 150000, nil},
+		{10000, IDR, 100, nil},
+		{10050, IDR, 0, ErrPrecisionLoss},
+		{1234, KWD, 1234, nil},
+	}
+
+	for _, tc := range tcs {
+		aa, err := New(tc.amount, tc.code).ToAdyen()
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v got %v", tc.err, err)
+			continue
+		}
+
+		if err == nil && aa.Value != tc.expected {
+			t.Errorf("Expected %d got %d", tc.expected, aa.Value)
+		}
+	}
+}
+
+func TestNewFromAdyen(t *testing.T) {
+	m, err := NewFromAdyen(AdyenAmount{Currency: CLP, Value: 150000})
+
+	if err != nil || m.Amount != 1500 || m.Currency.Code != CLP {
+		t.Errorf("Expected %d %s got %d %s (%v)", 1500, CLP, m.Amount, m.Currency.Code, err)
+	}
+
+	_, err = NewFromAdyen(AdyenAmount{Currency: CLP, Value: 150001})
+
+	if !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("Expected %v got %v", ErrPrecisionLoss, err)
+	}
+}
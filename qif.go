@@ -0,0 +1,64 @@
+package money
+
+import (
+	"strings"
+)
+
+// ParseQIFAmount parses a QIF amount field like "T-1,234.56" or "1.234,56" into Money.
+// The leading field type (T, U or $) is optional. QIF files don't declare their number
+// format, so the decimal separator used by the exporting application has to be given;
+// the other one of "." and "," is treated as a thousand separator, which must group the
+// integer digits by three. It returns ErrInvalidAmount for malformed amounts and
+// ErrPrecisionLoss for amounts with more decimals than the Currency.
+func ParseQIFAmount(s, code, decimal string) (*Money, error) {
+	in := s
+	s = strings.TrimSpace(s)
+	if s != "" && strings.ContainsRune("TU$", rune(s[0])) {
+		s = s[1:]
+	}
+	s = strings.ReplaceAll(s, " ", "")
+
+	thousand := ","
+	if decimal == "," {
+		thousand = "."
+	}
+
+	ip, fp, hasPoint := strings.Cut(s, decimal)
+	if strings.Contains(fp, thousand) {
+		return nil, &ParseError{Input: in, Err: ErrInvalidAmount}
+	}
+
+	sign := ""
+	if strings.HasPrefix(ip, "-") || strings.HasPrefix(ip, "+") {
+		sign, ip = ip[:1], ip[1:]
+	}
+
+	if groups := strings.Split(ip, thousand); len(groups) > 1 {
+		for i, g := range groups {
+			if (i == 0 && (g == "" || len(g) > 3)) || (i > 0 && len(g) != 3) {
+				return nil, &ParseError{Input: in, Err: ErrInvalidAmount}
+			}
+		}
+		ip = strings.Join(groups, "")
+	}
+
+	d := sign + ip
+	if hasPoint {
+		d += "." + fp
+	}
+
+	m, err := NewWithOptions(0, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Amount, err = parseDecimal(d, m.Currency.get().Fraction); err != nil {
+		// Report the input as given rather than its plain decimal form.
+		if perr, ok := err.(*ParseError); ok {
+			perr.Input = in
+		}
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,43 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseQIFAmount(t *testing.T) {
+	tcs := []struct {
+		value    string
+		decimal  string
+		expected Amount
+		err      error
+	}{
+		{"T-1,234.56", ".", -123456, nil},
+		{"U1,234.56", ".", 123456, nil},
+		{"1234", ".", 123400, nil},
+		{"T-1.234,56", ",", -123456, nil},
+		{"12,5", ",", 1250, nil},
+		{"T12.345", ".", 0, ErrPrecisionLoss},
+		{"Tabc", ".", 0, ErrInvalidAmount},
+		{"T12.3,4", ".", 0, ErrInvalidAmount},
+		{"T1,23.45", ".", 0, ErrInvalidAmount},
+		{"T,123.45", ".", 0, ErrInvalidAmount},
+		{"T1.2.3", ".", 0, ErrInvalidAmount},
+		{"T1.234,567", ",", 0, ErrPrecisionLoss},
+		{"T1.50", ".", 150, nil},
+		{"T1.500", ".", 150, nil},
+	}
+
+	for _, tc := range tcs {
+		m, err := ParseQIFAmount(tc.value, EUR, tc.decimal)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %q got %v", tc.err, tc.value, err)
+			continue
+		}
+
+		if err == nil && m.Amount != tc.expected {
+			t.Errorf("Expected %d for %q got %d", tc.expected, tc.value, m.Amount)
+		}
+	}
+}
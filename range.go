@@ -0,0 +1,193 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Range represents an inclusive interval of Money of a single Currency.
+// A nil Min or Max leaves the Range unbounded on that side, but not on both.
+type Range struct {
+	Min *Money
+	Max *Money
+}
+
+// NewRange creates and returns new validated Range.
+func NewRange(min, max *Money) (Range, error) {
+	r := Range{Min: min, Max: max}
+	if err := r.Validate(); err != nil {
+		return Range{}, err
+	}
+
+	return r, nil
+}
+
+// Validate checks that the Range has at least one bound, that both bounds share
+// the same Currency and that Min is not above Max. Bounds of different currencies
+// are reported as ErrInvalidRange wrapping ErrCurrencyMismatch.
+func (r Range) Validate() error {
+	switch {
+	case r.Min == nil && r.Max == nil:
+		return ErrInvalidRange
+	case r.Min == nil || r.Max == nil:
+		return nil
+	case !r.Min.SameCurrency(r.Max):
+		return fmt.Errorf("%w: %w", ErrInvalidRange, newCurrencyMismatch(r.Min.Currency, r.Max.Currency))
+	case r.Min.compare(r.Max) > 0:
+		return ErrInvalidRange
+	}
+
+	return nil
+}
+
+// currency returns the Currency of the Range bounds.
+func (r Range) currency() *Currency {
+	if r.Min != nil {
+		return r.Min.Currency
+	}
+
+	return r.Max.Currency
+}
+
+func (r Range) assertSameCurrency(c *Currency) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	if !r.currency().equals(c) {
+		return newCurrencyMismatch(r.currency(), c)
+	}
+
+	return nil
+}
+
+// Contains checks whether Money lies within the Range, bounds included.
+func (r Range) Contains(m *Money) (bool, error) {
+	if err := r.assertSameCurrency(m.Currency); err != nil {
+		return false, err
+	}
+
+	return (r.Min == nil || m.compare(r.Min) >= 0) && (r.Max == nil || m.compare(r.Max) <= 0), nil
+}
+
+// Clamp returns new Money limited to the Range, i.e. the nearest bound when Money lies
+// outside of it and a copy of Money otherwise.
+func (r Range) Clamp(m *Money) (*Money, error) {
+	if err := r.assertSameCurrency(m.Currency); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case r.Min != nil && m.compare(r.Min) < 0:
+		return &Money{Amount: r.Min.Amount, Currency: m.Currency}, nil
+	case r.Max != nil && m.compare(r.Max) > 0:
+		return &Money{Amount: r.Max.Amount, Currency: m.Currency}, nil
+	}
+
+	return &Money{Amount: m.Amount, Currency: m.Currency}, nil
+}
+
+// Overlaps checks whether the Range shares at least one value with the other.
+func (r Range) Overlaps(o Range) (bool, error) {
+	_, ok, err := r.Intersect(o)
+	return ok, err
+}
+
+// Intersect returns the Range of values shared with the other Range.
+// The returned boolean is false when the ranges don't overlap.
+func (r Range) Intersect(o Range) (Range, bool, error) {
+	if err := o.Validate(); err != nil {
+		return Range{}, false, err
+	}
+
+	if err := r.assertSameCurrency(o.currency()); err != nil {
+		return Range{}, false, err
+	}
+
+	i := Range{Min: r.Min, Max: r.Max}
+	if i.Min == nil || (o.Min != nil && o.Min.compare(i.Min) > 0) {
+		i.Min = o.Min
+	}
+
+	if i.Max == nil || (o.Max != nil && o.Max.compare(i.Max) < 0) {
+		i.Max = o.Max
+	}
+
+	if i.Min != nil && i.Max != nil && i.Min.compare(i.Max) > 0 {
+		return Range{}, false, nil
+	}
+
+	return i, true, nil
+}
+
+// rangeJSON is the JSON representation of Range, leaving out unbounded sides.
+type rangeJSON struct {
+	Min *Value `json:"min,omitempty"`
+	Max *Value `json:"max,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. Range is encoded with its bounds as Value,
+// e.g. {"min":{"amount":1000,"currency":"USD"},"max":{"amount":5000,"currency":"USD"}},
+// leaving out an unbounded side. Invalid ranges are rejected like by Validate.
+func (r Range) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	var v rangeJSON
+	if r.Min != nil {
+		mv := r.Min.ToValue()
+		v.Min = &mv
+	}
+	if r.Max != nil {
+		mv := r.Max.ToValue()
+		v.Max = &mv
+	}
+
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Malformed input is rejected with
+// ErrInvalidJSONUnmarshal and invalid ranges like by Validate.
+func (r *Range) UnmarshalJSON(data []byte) error {
+	var v rangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		// The bounds report their own errors as ErrInvalidJSONUnmarshal already.
+		if errors.Is(err, ErrInvalidJSONUnmarshal) {
+			return err
+		}
+		return fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, err)
+	}
+
+	var nr Range
+	var err error
+	if nr.Min, err = rangeBound(v.Min); err != nil {
+		return err
+	}
+	if nr.Max, err = rangeBound(v.Max); err != nil {
+		return err
+	}
+
+	if err := nr.Validate(); err != nil {
+		return err
+	}
+	*r = nr
+
+	return nil
+}
+
+// rangeBound returns the Money of a decoded bound, nil when the bound is missing.
+// Codes rejected by the UnknownCodePolicy are returned as ErrInvalidJSONUnmarshal.
+func rangeBound(v *Value) (*Money, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	m, err := NewWithOptions(int64(v.Amount), v.Code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, err)
+	}
+
+	return m, nil
+}
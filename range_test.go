@@ -0,0 +1,197 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNewRange(t *testing.T) {
+	tcs := []struct {
+		min, max *Money
+		err      error
+	}{
+		{New(100, EUR), New(200, EUR), nil},
+		{New(100, EUR), New(100, EUR), nil},
+		{New(100, EUR), nil, nil},
+		{nil, New(100, EUR), nil},
+		{nil, nil, ErrInvalidRange},
+		{New(200, EUR), New(100, EUR), ErrInvalidRange},
+		{New(100, EUR), New(200, USD), ErrInvalidRange},
+	}
+
+	for _, tc := range tcs {
+		if _, err := NewRange(tc.min, tc.max); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for [%v, %v] got %v", tc.err, tc.min, tc.max, err)
+		}
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	r, _ := NewRange(New(1000, USD), New(10000, USD))
+	above, _ := NewRange(New(1000, USD), nil)
+
+	tcs := []struct {
+		r        Range
+		amount   int64
+		expected bool
+	}{
+		{r, 999, false},
+		{r, 1000, true},
+		{r, 5000, true},
+		{r, 10000, true},
+		{r, 10001, false},
+		{above, 1 << 60, true},
+		{above, 0, false},
+	}
+
+	for _, tc := range tcs {
+		if ok, err := tc.r.Contains(New(tc.amount, USD)); err != nil || ok != tc.expected {
+			t.Errorf("Expected %v contains %d == %t got %t (%v)", tc.r, tc.amount, tc.expected, ok, err)
+		}
+	}
+
+	if _, err := r.Contains(New(1000, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestRange_Intersect(t *testing.T) {
+	r, _ := NewRange(New(1000, USD), New(5000, USD))
+
+	tcs := []struct {
+		o        Range
+		overlaps bool
+		min, max Amount
+	}{
+		{Range{Min: New(3000, USD), Max: New(8000, USD)}, true, 3000, 5000},
+		{Range{Min: New(5000, USD), Max: New(8000, USD)}, true, 5000, 5000},
+		{Range{Min: New(5001, USD), Max: New(8000, USD)}, false, 0, 0},
+		{Range{Max: New(2000, USD)}, true, 1000, 2000},
+		{Range{Min: New(0, USD)}, true, 1000, 5000},
+	}
+
+	for _, tc := range tcs {
+		i, ok, err := r.Intersect(tc.o)
+		if err != nil || ok != tc.overlaps {
+			t.Errorf("Expected %v overlaps %v == %t got %t (%v)", r, tc.o, tc.overlaps, ok, err)
+			continue
+		}
+
+		if ok && (i.Min.Amount != tc.min || i.Max.Amount != tc.max) {
+			t.Errorf("Expected intersection [%d, %d] got [%d, %d]", tc.min, tc.max, i.Min.Amount, i.Max.Amount)
+		}
+
+		if overlaps, _ := r.Overlaps(tc.o); overlaps != tc.overlaps {
+			t.Errorf("Expected %v overlaps %v == %t got %t", r, tc.o, tc.overlaps, overlaps)
+		}
+	}
+
+	if _, _, err := r.Intersect(Range{Min: New(0, EUR)}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestRange_Clamp(t *testing.T) {
+	r, _ := NewRange(New(1000, USD), New(5000, USD))
+	below, _ := NewRange(nil, New(5000, USD))
+
+	tcs := []struct {
+		r        Range
+		amount   int64
+		expected Amount
+	}{
+		{r, 999, 1000},
+		{r, 1000, 1000},
+		{r, 3000, 3000},
+		{r, 5001, 5000},
+		{below, -1 << 60, -1 << 60},
+		{below, 1 << 60, 5000},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, USD)
+		c, err := tc.r.Clamp(m)
+		if err != nil || c.Amount != tc.expected || !c.SameCurrency(m) {
+			t.Errorf("Expected %d clamped to %v to be %d got %v (%v)", tc.amount, tc.r, tc.expected, c, err)
+		}
+
+		if c == m || c == tc.r.Min || c == tc.r.Max {
+			t.Error("Expected Clamp to return new Money")
+		}
+	}
+
+	if _, err := r.Clamp(New(1000, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestRange_Validate(t *testing.T) {
+	err := Range{Min: New(100, EUR), Max: New(200, USD)}.Validate()
+	if !errors.Is(err, ErrInvalidRange) || !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v and %v got %v", ErrInvalidRange, ErrCurrencyMismatch, err)
+	}
+}
+
+func TestRange_JSON(t *testing.T) {
+	tcs := []struct {
+		r        Range
+		expected string
+	}{
+		{Range{Min: New(1000, USD), Max: New(5000, USD)}, `{"min":{"amount":1000,"currency":"USD"},"max":{"amount":5000,"currency":"USD"}}`},
+		{Range{Min: New(-5, EUR)}, `{"min":{"amount":-5,"currency":"EUR"}}`},
+		{Range{Max: New(0, JPY)}, `{"max":{"amount":0,"currency":"JPY"}}`},
+	}
+
+	for _, tc := range tcs {
+		b, err := json.Marshal(tc.r)
+		if err != nil || string(b) != tc.expected {
+			t.Errorf("Expected %s got %s (%v)", tc.expected, b, err)
+			continue
+		}
+
+		var r Range
+		if err := json.Unmarshal(b, &r); err != nil {
+			t.Errorf("Expected %s to unmarshal got %v", b, err)
+			continue
+		}
+
+		if (r.Min == nil) != (tc.r.Min == nil) || (r.Max == nil) != (tc.r.Max == nil) ||
+			(r.Min != nil && !r.Min.EqualValue(tc.r.Min)) || (r.Max != nil && !r.Max.EqualValue(tc.r.Max)) {
+			t.Errorf("Expected %v got %v", tc.r, r)
+		}
+	}
+
+	if _, err := json.Marshal(Range{}); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Expected %v got %v", ErrInvalidRange, err)
+	}
+}
+
+func TestRange_UnmarshalJSONErrors(t *testing.T) {
+	tcs := []struct {
+		input string
+		err   error
+	}{
+		{`[]`, ErrInvalidJSONUnmarshal},
+		{`{"min":{"amount":1.5,"currency":"USD"}}`, ErrInvalidJSONUnmarshal},
+		{`{}`, ErrInvalidRange},
+		{`{"min":{"amount":2,"currency":"USD"},"max":{"amount":1,"currency":"USD"}}`, ErrInvalidRange},
+		{`{"min":{"amount":1,"currency":"USD"},"max":{"amount":2,"currency":"eur"}}`, ErrCurrencyMismatch},
+	}
+
+	for _, tc := range tcs {
+		var r Range
+		if err := json.Unmarshal([]byte(tc.input), &r); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %s got %v", tc.err, tc.input, err)
+		}
+	}
+
+	SetUnknownCodePolicy(UnknownCodeError())
+	defer SetUnknownCodePolicy(nil)
+
+	var r Range
+	err := json.Unmarshal([]byte(`{"min":{"amount":1,"currency":"XXQ"}}`), &r)
+	if !errors.Is(err, ErrInvalidJSONUnmarshal) || !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v for unknown code got %v", ErrUnsupportedCurrency, err)
+	}
+}
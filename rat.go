@@ -0,0 +1,81 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// AsRat returns the exact value of Money in major units as a rational number.
+func (m *Money) AsRat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(int64(m.Amount)), scale(m.Currency.get().Fraction))
+}
+
+// AsBigFloat returns the value of Money in major units as a big.Float with given precision.
+// A precision of 0 is changed to 64 as it is done by big.Float.SetRat.
+func (m *Money) AsBigFloat(prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec).SetRat(m.AsRat())
+}
+
+// SetRat sets Money Amount to the value of r in major units, rounded to the
+// Currency fraction with given rounding mode, and returns m.
+// It returns ErrOverflow and leaves m unchanged when the result doesn't fit into Amount.
+func (m *Money) SetRat(r *big.Rat, mode RoundingMode) (*Money, error) {
+	n := new(big.Int).Mul(r.Num(), scale(m.Currency.get().Fraction))
+	a := roundQuo(n, r.Denom(), mode)
+
+	if !a.IsInt64() {
+		return m, ErrOverflow
+	}
+	m.Amount = Amount(a.Int64())
+
+	return m, nil
+}
+
+// MultiplyRat returns new Money with value of Self multiplied by r, e.g. a tax, interest
+// or exchange rate. The product is computed exactly and rounded once to minor units
+// with given rounding mode. When it doesn't fit into Amount it returns ErrOverflow, or
+// panics or saturates depending on the active Policy.
+func (m *Money) MultiplyRat(r *big.Rat, mode RoundingMode) (*Money, error) {
+	a, ok := calcMultiplyRat(m.Amount, r, mode)
+	if !ok {
+		var err error
+		if a, err = overflowed(saturation((m.Amount < 0) == (r.Sign() < 0))); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Money{Amount: a, Currency: m.Currency}, nil
+}
+
+// MultiplyRatio works like MultiplyRat with the ratio num/den, e.g. 7/100 for 7% or 1/3
+// to divide by three. It returns ErrInvalidRatio for a zero den.
+func (m *Money) MultiplyRatio(num, den int64, mode RoundingMode) (*Money, error) {
+	if den == 0 {
+		return nil, fmt.Errorf("%w: zero denominator", ErrInvalidRatio)
+	}
+
+	return m.MultiplyRat(big.NewRat(num, den), mode)
+}
+
+// Divide returns new Money with value of Self divided by div, rounded to minor units
+// with the default RoundingMode, see SetDefaultRounding. It returns ErrInvalidRatio for
+// a zero div and ErrOverflow, or panics or saturates depending on the active Policy,
+// when dividing MinAmount by -1.
+func (m *Money) Divide(div int64) (*Money, error) {
+	return m.MultiplyRatio(1, div, GetDefaultRounding())
+}
+
+// MultiplyFloat works like MultiplyRat with f taken as its shortest decimal
+// representation, so 1.1 is 11/10 rather than the binary value closest to it. It
+// returns ErrInvalidAmount for NaN and infinities.
+func (m *Money) MultiplyFloat(f float64, mode RoundingMode) (*Money, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, ErrInvalidAmount
+	}
+
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+
+	return m.MultiplyRat(r, mode)
+}
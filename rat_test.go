@@ -0,0 +1,161 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestMoney_AsRat(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{1099, USD, "1099/100"},
+		{-5, EUR, "-1/20"},
+		{1500, JPY, "1500/1"},
+		{1, KWD, "1/1000"},
+	}
+
+	for _, tc := range tcs {
+		if r := New(tc.amount, tc.code).AsRat(); r.String() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_AsBigFloat(t *testing.T) {
+	f := New(1099, USD).AsBigFloat(0)
+
+	if s := f.Text('f', 2); s != "10.99" {
+		t.Errorf("Expected %s got %s", "10.99", s)
+	}
+}
+
+func TestMoney_SetRat(t *testing.T) {
+	tcs := []struct {
+		rat      string
+		mode     RoundingMode
+		expected Amount
+	}{
+		{"1099/100", RoundHalfUp, 1099},
+		{"1/3", RoundHalfUp, 33},
+		{"2/3", RoundHalfUp, 67},
+		{"2/3", RoundDown, 66},
+		{"-2/3", RoundFloor, -67},
+		{"1/200", RoundHalfEven, 0},
+		{"3/200", RoundHalfEven, 2},
+	}
+
+	for _, tc := range tcs {
+		r, _ := new(big.Rat).SetString(tc.rat)
+		m, err := New(0, EUR).SetRat(r, tc.mode)
+
+		if err != nil || m.Amount != tc.expected {
+			t.Errorf("Expected %s rounded %s to be %d got %d (%v)", tc.rat, tc.mode, tc.expected, m.Amount, err)
+		}
+	}
+
+	m := New(42, EUR)
+	r, _ := new(big.Rat).SetString("1e20")
+	if _, err := m.SetRat(r, RoundHalfUp); !errors.Is(err, ErrOverflow) || m.Amount != 42 {
+		t.Errorf("Expected %v and unchanged amount got %v and %d", ErrOverflow, err, m.Amount)
+	}
+}
+
+func TestMoney_MultiplyRatio(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		num, den int64
+		mode     RoundingMode
+		expected Amount
+	}{
+		{1000, 7, 100, RoundHalfUp, 70},
+		{1050, 1, 3, RoundHalfUp, 350},
+		{100, 1, 3, RoundHalfUp, 33},
+		{100, 2, 3, RoundDown, 66},
+		{-100, 2, 3, RoundFloor, -67},
+		{-100, 2, -3, RoundHalfUp, 67},
+		{25, 1, 10, RoundHalfEven, 2},
+		{35, 1, 10, RoundHalfEven, 4},
+		{1, 1, 1000, RoundUp, 1},
+		{int64(MaxAmount), 3, 3, RoundHalfUp, MaxAmount},
+		{int64(MaxAmount), 2, 3, RoundHalfUp, 6148914691236517205},
+	}
+
+	for _, tc := range tcs {
+		m, err := New(tc.amount, EUR).MultiplyRatio(tc.num, tc.den, tc.mode)
+		if err != nil || m.Amount != tc.expected {
+			t.Errorf("Expected %d * %d/%d rounded %s to be %d got %v (%v)", tc.amount, tc.num, tc.den, tc.mode, tc.expected, m, err)
+		}
+	}
+
+	if _, err := New(1, EUR).MultiplyRatio(1, 0, RoundHalfUp); !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Expected %v got %v", ErrInvalidRatio, err)
+	}
+}
+
+func TestMoney_MultiplyFloat(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		f        float64
+		mode     RoundingMode
+		expected Amount
+	}{
+		{1000, 1.1, RoundHalfUp, 1100},
+		{1999, 0.2, RoundHalfUp, 400},
+		{1999, 0.2, RoundDown, 399},
+		{5, 0.5, RoundHalfEven, 2},
+		{-5, 0.5, RoundHalfUp, -3},
+		{12345, 1.0825, RoundHalfUp, 13363},
+	}
+
+	for _, tc := range tcs {
+		m, err := New(tc.amount, USD).MultiplyFloat(tc.f, tc.mode)
+		if err != nil || m.Amount != tc.expected {
+			t.Errorf("Expected %d * %v rounded %s to be %d got %v (%v)", tc.amount, tc.f, tc.mode, tc.expected, m, err)
+		}
+	}
+
+	for _, f := range []float64{math.NaN(), math.Inf(1)} {
+		if _, err := New(1, USD).MultiplyFloat(f, RoundHalfUp); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected %v for %v got %v", ErrInvalidAmount, f, err)
+		}
+	}
+}
+
+func TestMoney_MultiplyRatOverflow(t *testing.T) {
+	defer SetPolicy(PolicyError)
+
+	m := New(int64(MaxAmount)/2+1, USD)
+	if _, err := m.MultiplyRatio(2, 1, RoundHalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	SetPolicy(PolicySaturate)
+	tcs := []struct {
+		num      int64
+		expected Amount
+	}{
+		{2, MaxAmount},
+		{-2, MinAmount},
+	}
+
+	for _, tc := range tcs {
+		if r, err := m.MultiplyRatio(tc.num, 1, RoundHalfUp); err != nil || r.Amount != tc.expected {
+			t.Errorf("Expected %d got %v (%v)", tc.expected, r, err)
+		}
+	}
+}
+
+func TestMoney_Divide(t *testing.T) {
+	if m, err := New(1000, EUR).Divide(3); err != nil || m.Amount != 333 {
+		t.Errorf("Expected %d got %v (%v)", 333, m, err)
+	}
+
+	if _, err := New(1000, EUR).Divide(0); !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Expected %v got %v", ErrInvalidRatio, err)
+	}
+}
@@ -0,0 +1,132 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RateProvider provides exchange rates between currencies.
+type RateProvider interface {
+	// Rate returns the value of one major unit of the from currency in major units of the
+	// to currency. It returns an error matching ErrNoRate when it has no such rate.
+	Rate(from, to string) (*big.Rat, error)
+}
+
+// RateProviderFunc adapts a function to RateProvider.
+type RateProviderFunc func(from, to string) (*big.Rat, error)
+
+// Rate calls f(from, to).
+func (f RateProviderFunc) Rate(from, to string) (*big.Rat, error) {
+	return f(from, to)
+}
+
+// StaticRates is a RateProvider of fixed rates keyed by the from and the to code, e.g.
+// StaticRates{"EUR": {"USD": big.NewRat(108, 100)}}. Inverse rates are derived when
+// only the opposite direction is given.
+type StaticRates map[string]map[string]*big.Rat
+
+// Rate implements RateProvider.
+func (s StaticRates) Rate(from, to string) (*big.Rat, error) {
+	from, to = canonicalCode(from), canonicalCode(to)
+	if r, ok := s[from][to]; ok {
+		return new(big.Rat).Set(r), nil
+	}
+
+	if r, ok := s[to][from]; ok && r.Sign() != 0 {
+		return new(big.Rat).Inv(r), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s to %s", ErrNoRate, from, to)
+}
+
+// ExchangeRate is the rate converting Money of the From currency into the To currency.
+type ExchangeRate struct {
+	From, To string
+	// Rate is the value of one major unit of From in major units of To.
+	Rate *big.Rat
+	// Rounding rounds converted amounts to the minor units of To.
+	Rounding RoundingMode
+}
+
+// ScaledRate returns the rate given as an integer scaled by 10^exp, e.g. 108250 with an
+// exp of 5 for 1.0825, the way rate feeds often publish them.
+func ScaledRate(v int64, exp int) *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(v), scale(exp))
+}
+
+// LookupRate returns the ExchangeRate from one currency to another provided by the
+// RateProvider, with given rounding mode.
+func LookupRate(rp RateProvider, from, to string, mode RoundingMode) (ExchangeRate, error) {
+	r, err := rp.Rate(from, to)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+
+	return ExchangeRate{From: canonicalCode(from), To: canonicalCode(to), Rate: r, Rounding: mode}, nil
+}
+
+// Convert returns new Money converted to the target currency with given ExchangeRate,
+// computed exactly and rounded once to the fraction of the target with the Rounding of
+// the rate. Money already in the target currency is returned as is. It returns
+// CurrencyMismatchError when the From or To of the rate don't match, ErrNoRate for a rate
+// that is nil or not positive and ErrOverflow when the result doesn't fit into Amount.
+func (m *Money) Convert(target string, rate ExchangeRate) (*Money, error) {
+	if !m.IsValid() {
+		return nil, ErrNilMoney
+	}
+
+	from := canonicalCode(m.Currency.Code)
+	if from == canonicalCode(target) {
+		return m, nil
+	}
+
+	if canonicalCode(rate.From) != from {
+		return nil, &CurrencyMismatchError{A: m.Currency.Code, B: rate.From}
+	}
+
+	if canonicalCode(rate.To) != canonicalCode(target) {
+		return nil, &CurrencyMismatchError{A: target, B: rate.To}
+	}
+
+	if rate.Rate == nil || rate.Rate.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: invalid rate from %s to %s", ErrNoRate, rate.From, rate.To)
+	}
+
+	c, err := resolveCode(target)
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := (&Money{Currency: c}).SetRat(new(big.Rat).Mul(m.AsRat(), rate.Rate), rate.Rounding)
+	if err != nil {
+		return nil, err
+	}
+
+	return cm, nil
+}
+
+// Convert converts Money to given currency with the rate of the RateProvider, rounded
+// with given mode, and returns it along with the rate used. Money already in the currency
+// is returned as is with a rate of one. It returns the errors of the RateProvider and
+// ErrOverflow when the result doesn't fit into Amount.
+func Convert(m *Money, to string, rp RateProvider, mode RoundingMode) (*Money, *big.Rat, error) {
+	if !m.IsValid() {
+		return nil, nil, ErrNilMoney
+	}
+
+	if canonicalCode(m.Currency.Code) == canonicalCode(to) {
+		return m, big.NewRat(1, 1), nil
+	}
+
+	er, err := LookupRate(rp, m.Currency.Code, to, mode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cm, err := m.Convert(to, er)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cm, er.Rate, nil
+}
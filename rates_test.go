@@ -0,0 +1,112 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+var testRates = StaticRates{
+	EUR: {USD: big.NewRat(108, 100)},
+	USD: {JPY: big.NewRat(150, 1)},
+}
+
+func TestStaticRates(t *testing.T) {
+	tcs := []struct {
+		from, to string
+		expected *big.Rat
+		err      error
+	}{
+		{EUR, USD, big.NewRat(108, 100), nil},
+		{"usd", "eur", big.NewRat(100, 108), nil},
+		{JPY, USD, big.NewRat(1, 150), nil},
+		{EUR, JPY, nil, ErrNoRate},
+	}
+
+	for _, tc := range tcs {
+		r, err := testRates.Rate(tc.from, tc.to)
+		if !errors.Is(err, tc.err) || (err == nil && r.Cmp(tc.expected) != 0) {
+			t.Errorf("Expected rate %v (%v) from %s to %s got %v (%v)", tc.expected, tc.err, tc.from, tc.to, r, err)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		to       string
+		expected *Money
+	}{
+		{New(1000, EUR), USD, New(1080, USD)},
+		{New(1000, USD), JPY, New(1500, JPY)},
+		{New(1, USD), EUR, New(1, EUR)},
+		{New(1000, USD), "usd", New(1000, USD)},
+	}
+
+	for _, tc := range tcs {
+		m, _, err := Convert(tc.money, tc.to, testRates, RoundHalfEven)
+		if err != nil || !m.Equal(tc.expected) {
+			t.Errorf("Expected %v converting %v got %v (%v)", tc.expected.Display(), tc.money.Display(), m, err)
+		}
+	}
+
+	failing := RateProviderFunc(func(from, to string) (*big.Rat, error) { return nil, ErrNoRate })
+	if _, _, err := Convert(New(1, EUR), USD, failing, RoundHalfEven); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+}
+
+func TestMoney_Convert(t *testing.T) {
+	tcs := []struct {
+		m        *Money
+		target   string
+		rate     ExchangeRate
+		expected *Money
+	}{
+		{New(1000, EUR), USD, ExchangeRate{From: EUR, To: USD, Rate: ScaledRate(108250, 5)}, New(1083, USD)},
+		{New(1000, EUR), USD, ExchangeRate{From: EUR, To: USD, Rate: ScaledRate(108250, 5), Rounding: RoundDown}, New(1082, USD)},
+		{New(1000, USD), JPY, ExchangeRate{From: USD, To: JPY, Rate: big.NewRat(14955, 100)}, New(1496, JPY)},
+		{New(1000, USD), KWD, ExchangeRate{From: "usd", To: "kwd", Rate: ScaledRate(307, 3)}, New(3070, KWD)},
+		{New(-1000, USD), EUR, ExchangeRate{From: USD, To: EUR, Rate: big.NewRat(1, 3), Rounding: RoundFloor}, New(-334, EUR)},
+		{New(1000, USD), USD, ExchangeRate{}, New(1000, USD)},
+	}
+
+	for _, tc := range tcs {
+		r, err := tc.m.Convert(tc.target, tc.rate)
+		if err != nil || !r.Equal(tc.expected) {
+			t.Errorf("Expected %v got %v (%v)", tc.expected, r, err)
+		}
+	}
+}
+
+func TestMoney_ConvertErrors(t *testing.T) {
+	rate := ScaledRate(11, 1)
+
+	tcs := []struct {
+		rate ExchangeRate
+		err  error
+	}{
+		{ExchangeRate{From: GBP, To: USD, Rate: rate}, ErrCurrencyMismatch},
+		{ExchangeRate{From: EUR, To: GBP, Rate: rate}, ErrCurrencyMismatch},
+		{ExchangeRate{From: EUR, To: USD}, ErrNoRate},
+		{ExchangeRate{From: EUR, To: USD, Rate: big.NewRat(-1, 1)}, ErrNoRate},
+		{ExchangeRate{From: EUR, To: USD, Rate: big.NewRat(1e18, 1)}, ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		if _, err := New(1000, EUR).Convert(USD, tc.rate); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %v got %v", tc.err, tc.rate, err)
+		}
+	}
+}
+
+func TestLookupRate(t *testing.T) {
+	er, err := LookupRate(testRates, "eur", "usd", RoundUp)
+	if err != nil || er.From != EUR || er.To != USD || er.Rate.Cmp(big.NewRat(108, 100)) != 0 || er.Rounding != RoundUp {
+		t.Errorf("Expected rate from %s to %s got %+v (%v)", EUR, USD, er, err)
+	}
+
+	if _, err := LookupRate(testRates, EUR, JPY, RoundUp); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+}
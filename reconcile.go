@@ -0,0 +1,177 @@
+package money
+
+// Record is Money identified by a key, e.g. a line of a settlement file or a ledger entry.
+type Record struct {
+	// Key identifies the record on both sides, e.g. a transaction reference. Records
+	// with a key are only matched by key, records without key only by amount.
+	Key   string
+	Money *Money
+}
+
+// Match is a pair of records of two reconciled collections.
+type Match struct {
+	A, B Record
+	// Difference is B less A, nil when A and B differ in Currency.
+	Difference *Money
+}
+
+// Reconciliation is the result of Reconcile.
+type Reconciliation struct {
+	// Matched are pairs with the same Currency whose amounts are within the tolerance.
+	Matched []Match
+	// Mismatched are pairs with the same key whose amounts differ by more than the
+	// tolerance or whose currencies differ.
+	Mismatched []Match
+	// OnlyA are the records of A missing in B, OnlyB the records of B missing in A.
+	OnlyA, OnlyB []Record
+	// Net is the sum of B less the sum of A per currency, ordered by currency code.
+	Net []*Money
+}
+
+// IsReconciled reports whether all records are matched and the collections net to zero.
+func (r *Reconciliation) IsReconciled() bool {
+	if len(r.Mismatched) > 0 || len(r.OnlyA) > 0 || len(r.OnlyB) > 0 {
+		return false
+	}
+
+	for _, m := range r.Net {
+		if !m.IsZero() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Reconcile matches the records of two collections, e.g. an internal ledger against a
+// settlement file. Records are first matched by key, the first unmatched record of B with
+// the key of a record of A being its counterpart. The records without key are matched by
+// Currency and amount, each record of A in order taking the unmatched record of B closest
+// to its amount within the tolerance, in minor units. It returns ErrNilMoney for records
+// without Money and ErrOverflow when a difference or net doesn't fit into Amount.
+func Reconcile(a, b []Record, tolerance Amount) (*Reconciliation, error) {
+	net := &Bag{}
+	for _, r := range a {
+		if !r.Money.IsValid() {
+			return nil, ErrNilMoney
+		}
+
+		if err := net.Subtract(r.Money); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, r := range b {
+		if !r.Money.IsValid() {
+			return nil, ErrNilMoney
+		}
+
+		if err := net.Add(r.Money); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &Reconciliation{Net: net.Totals()}
+	matchedA, matchedB := make([]bool, len(a)), make([]bool, len(b))
+
+	// Match by key.
+	byKey := make(map[string][]int)
+	for j, r := range b {
+		if r.Key != "" {
+			byKey[r.Key] = append(byKey[r.Key], j)
+		}
+	}
+
+	for i, ra := range a {
+		js := byKey[ra.Key]
+		if ra.Key == "" || len(js) == 0 {
+			continue
+		}
+		j := js[0]
+		byKey[ra.Key] = js[1:]
+
+		m, ok, err := match(ra, b[j], tolerance)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			res.Matched = append(res.Matched, m)
+		} else {
+			res.Mismatched = append(res.Mismatched, m)
+		}
+		matchedA[i], matchedB[j] = true, true
+	}
+
+	// Match the records without key by amount.
+	for i, ra := range a {
+		if ra.Key != "" {
+			continue
+		}
+
+		best, bestDiff := -1, Amount(0)
+		for j, rb := range b {
+			if rb.Key != "" || matchedB[j] || !ra.Money.SameCurrency(rb.Money) {
+				continue
+			}
+
+			d, ok := calcSubtract(rb.Money.Amount, ra.Money.Amount)
+			if !ok {
+				continue
+			}
+
+			d, ok = calcAbsolute(d)
+			if !ok || d > tolerance {
+				continue
+			}
+
+			if best < 0 || d < bestDiff {
+				best, bestDiff = j, d
+			}
+		}
+
+		if best < 0 {
+			continue
+		}
+
+		m, _, err := match(ra, b[best], tolerance)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Matched = append(res.Matched, m)
+		matchedA[i], matchedB[best] = true, true
+	}
+
+	for i, r := range a {
+		if !matchedA[i] {
+			res.OnlyA = append(res.OnlyA, r)
+		}
+	}
+
+	for j, r := range b {
+		if !matchedB[j] {
+			res.OnlyB = append(res.OnlyB, r)
+		}
+	}
+
+	return res, nil
+}
+
+// match returns the Match of given records and whether their amounts are within the tolerance.
+func match(a, b Record, tolerance Amount) (Match, bool, error) {
+	m := Match{A: a, B: b}
+	if !a.Money.SameCurrency(b.Money) {
+		return m, false, nil
+	}
+
+	d, ok := calcSubtract(b.Money.Amount, a.Money.Amount)
+	if !ok {
+		return m, false, ErrOverflow
+	}
+	m.Difference = &Money{Amount: d, Currency: a.Money.Currency}
+
+	abs, ok := calcAbsolute(d)
+
+	return m, ok && abs <= tolerance, nil
+}
@@ -0,0 +1,115 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReconcile(t *testing.T) {
+	ledger := []Record{
+		{"tx1", New(1000, EUR)},
+		{"tx2", New(2500, EUR)},
+		{"tx3", New(700, USD)},
+		{"", New(1999, EUR)},
+		{"tx5", New(300, EUR)},
+		{"tx6", New(100, EUR)},
+	}
+	settlement := []Record{
+		{"tx1", New(1000, EUR)},
+		{"tx2", New(2400, EUR)},
+		{"tx3", New(700, EUR)},
+		{"", New(2000, EUR)},
+		{"", New(2001, EUR)},
+		{"tx7", New(50, GBP)},
+	}
+
+	r, err := Reconcile(ledger, settlement, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := func(ms []Match) []string {
+		var ks []string
+		for _, m := range ms {
+			ks = append(ks, m.A.Key+"="+m.B.Money.Key())
+		}
+		return ks
+	}
+
+	expected := map[string][]string{
+		"matched":    {"tx1=EUR:1000", "=EUR:2000"},
+		"mismatched": {"tx2=EUR:2400", "tx3=EUR:700"},
+	}
+	got := map[string][]string{"matched": keys(r.Matched), "mismatched": keys(r.Mismatched)}
+
+	for k, e := range expected {
+		if len(got[k]) != len(e) {
+			t.Errorf("Expected %s %v got %v", k, e, got[k])
+			continue
+		}
+		for i := range e {
+			if got[k][i] != e[i] {
+				t.Errorf("Expected %s %v got %v", k, e, got[k])
+				break
+			}
+		}
+	}
+
+	if d := r.Mismatched[0].Difference; d.Amount != -100 {
+		t.Errorf("Expected difference %d got %v", -100, d)
+	}
+
+	if r.Mismatched[1].Difference != nil {
+		t.Errorf("Expected no difference across currencies got %v", r.Mismatched[1].Difference)
+	}
+
+	if len(r.OnlyA) != 2 || r.OnlyA[0].Key != "tx5" || r.OnlyA[1].Key != "tx6" {
+		t.Errorf("Expected tx5 and tx6 only in A got %v", r.OnlyA)
+	}
+
+	if len(r.OnlyB) != 2 || r.OnlyB[0].Money.Amount != 2001 || r.OnlyB[1].Key != "tx7" {
+		t.Errorf("Expected EUR:2001 and tx7 only in B got %v", r.OnlyB)
+	}
+
+	net := []*Money{New(2202, EUR), New(50, GBP), New(-700, USD)}
+	if !EqualSlices(r.Net, net) {
+		t.Errorf("Expected net %v got %v", amounts(net), amounts(r.Net))
+	}
+
+	if r.IsReconciled() {
+		t.Errorf("Expected unreconciled")
+	}
+}
+
+func TestReconcile_Reconciled(t *testing.T) {
+	a := []Record{{"1", New(100, EUR)}, {"", New(200, USD)}}
+	b := []Record{{"", New(200, USD)}, {"1", New(100, EUR)}}
+
+	r, err := Reconcile(a, b, 0)
+	if err != nil || !r.IsReconciled() || len(r.Matched) != 2 {
+		t.Errorf("Expected reconciled collections got %+v (%v)", r, err)
+	}
+
+	if _, err := Reconcile([]Record{{"1", nil}}, nil, 0); !errors.Is(err, ErrNilMoney) {
+		t.Errorf("Expected %v got %v", ErrNilMoney, err)
+	}
+}
+
+func TestReconcile_KeyedAmounts(t *testing.T) {
+	a := []Record{{"x", New(10, EUR)}, {"", New(0, EUR)}}
+	b := []Record{{"y", New(10, EUR)}, {"", New(int64(MinAmount), EUR)}}
+
+	r, err := Reconcile(a, b, MaxAmount)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r.Matched) != 0 || len(r.OnlyA) != 2 || len(r.OnlyB) != 2 {
+		t.Errorf("Expected keyed records and amounts differing beyond Amount to stay unmatched got %+v", r)
+	}
+
+	r, err = Reconcile([]Record{{"", New(-int64(MaxAmount), EUR)}}, []Record{{"", New(0, EUR)}}, MaxAmount)
+	if err != nil || len(r.Matched) != 1 {
+		t.Errorf("Expected a match within the tolerance got %+v (%v)", r, err)
+	}
+}
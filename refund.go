@@ -0,0 +1,98 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+)
+
+// AllocateRefund splits a partial refund of Money previously split or allocated into
+// parts, e.g. between a seller, fees and taxes, and returns the refund of each party.
+// refunded holds what was refunded to each party before, nil for nothing. Refunds move
+// the total refunded to each party towards its proportional share of all refunds, so
+// successive partial refunds don't drift and a full refund returns exactly the parts.
+// The refunds sum to refund, are never negative and never take a party over its part.
+// It returns ErrInvalidAmount for negative Money, ErrCurrencyMismatch when Money don't
+// share a Currency, ErrInvalidAllocation when refunded doesn't match parts and
+// ErrExceedsCapture when the refunds would exceed the parts.
+func AllocateRefund(parts, refunded []*Money, refund *Money) ([]*Money, error) {
+	if !refund.IsValid() {
+		return nil, ErrNilMoney
+	}
+
+	if refunded == nil {
+		refunded = make([]*Money, len(parts))
+		for i := range refunded {
+			refunded[i] = &Money{Currency: refund.Currency}
+		}
+	}
+
+	if len(parts) == 0 || len(refunded) != len(parts) {
+		return nil, fmt.Errorf("%w: %d refunded for %d parts", ErrInvalidAllocation, len(refunded), len(parts))
+	}
+
+	for _, m := range slices.Concat(parts, refunded, []*Money{refund}) {
+		if err := refund.assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+
+		if m.IsNegative() {
+			return nil, ErrInvalidAmount
+		}
+	}
+
+	// Work with exact integers, weights are refund shares scaled by the sum of parts.
+	total, after := new(big.Int), big.NewInt(int64(refund.Amount))
+	for i, p := range parts {
+		if refunded[i].Amount > p.Amount {
+			return nil, ErrExceedsCapture
+		}
+		total.Add(total, big.NewInt(int64(p.Amount)))
+		after.Add(after, big.NewInt(int64(refunded[i].Amount)))
+	}
+
+	if after.Cmp(total) > 0 {
+		return nil, ErrExceedsCapture
+	}
+
+	// The weight of a party is how far it's below its share of all refunds, the sum of
+	// weights is at least the refund so no party gets more than its weight.
+	weights, sum := make([]*big.Int, len(parts)), new(big.Int)
+	for i, p := range parts {
+		w := new(big.Int).Mul(after, big.NewInt(int64(p.Amount)))
+		w.Sub(w, new(big.Int).Mul(total, big.NewInt(int64(refunded[i].Amount))))
+		if w.Sign() < 0 {
+			w.SetInt64(0)
+		}
+		weights[i] = w
+		sum.Add(sum, w)
+	}
+
+	rs := make([]*Money, len(parts))
+	rems := make([]*big.Int, len(parts))
+	lo := refund.Amount
+	for i, w := range weights {
+		rs[i] = &Money{Currency: refund.Currency}
+		if sum.Sign() == 0 {
+			rems[i] = new(big.Int)
+			continue
+		}
+
+		q, r := new(big.Int).QuoRem(new(big.Int).Mul(big.NewInt(int64(refund.Amount)), w), sum, new(big.Int))
+		rs[i].Amount, rems[i] = Amount(q.Int64()), r
+		lo -= rs[i].Amount
+	}
+
+	// Leftover goes to the parties with the largest remainders, each at most once.
+	order := make([]int, len(parts))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return rems[b].Cmp(rems[a]) })
+
+	for _, i := range order[:int(lo)] {
+		rs[i].Amount++
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,90 @@
+package money
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestAllocateRefund(t *testing.T) {
+	tcs := []struct {
+		parts    []int64
+		refunded []int64
+		refund   int64
+		expected []int64
+		err      error
+	}{
+		{[]int64{8000, 1500, 500}, nil, 5000, []int64{4000, 750, 250}, nil},
+		{[]int64{8000, 1500, 500}, nil, 10000, []int64{8000, 1500, 500}, nil},
+		{[]int64{1, 1}, nil, 1, []int64{1, 0}, nil},
+		{[]int64{1, 1}, []int64{1, 0}, 1, []int64{0, 1}, nil},
+		{[]int64{0, 1, 1}, nil, 1, []int64{0, 1, 0}, nil},
+		{[]int64{700, 300}, []int64{0, 300}, 500, []int64{500, 0}, nil},
+		{[]int64{100, 100}, []int64{50, 50}, 101, nil, ErrExceedsCapture},
+		{[]int64{100, 100}, []int64{101, 0}, 0, nil, ErrExceedsCapture},
+		{[]int64{100, 100}, []int64{0}, 10, nil, ErrInvalidAllocation},
+		{[]int64{100, -100}, nil, 0, nil, ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		var refunded []*Money
+		if tc.refunded != nil {
+			refunded = moneys(USD, tc.refunded...)
+		}
+
+		rs, err := AllocateRefund(moneys(USD, tc.parts...), refunded, New(tc.refund, USD))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v refunding %d of %v got %v", tc.err, tc.refund, tc.parts, err)
+			continue
+		}
+
+		if err == nil && !reflect.DeepEqual(amounts(rs), tc.expected) {
+			t.Errorf("Expected %v refunding %d of %v got %v", tc.expected, tc.refund, tc.parts, amounts(rs))
+		}
+	}
+
+	if _, err := AllocateRefund(moneys(USD, 100), nil, New(10, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestAllocateRefund_Successive(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for n := 0; n < 200; n++ {
+		parts := make([]int64, 1+r.Intn(5))
+		var total int64
+		for i := range parts {
+			parts[i] = r.Int63n(1000)
+			total += parts[i]
+		}
+
+		refunded := moneys(USD, make([]int64, len(parts))...)
+		for left := total; left > 0; {
+			refund := 1 + r.Int63n(left)
+			rs, err := AllocateRefund(moneys(USD, parts...), refunded, New(refund, USD))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var sum int64
+			for i, m := range rs {
+				sum += int64(m.Amount)
+				refunded[i].Amount += m.Amount
+				if m.Amount < 0 || int64(refunded[i].Amount) > parts[i] {
+					t.Fatalf("Expected refunds within %v got %v", parts, amounts(refunded))
+				}
+			}
+
+			if sum != refund {
+				t.Fatalf("Expected refunds summing to %d got %v", refund, amounts(rs))
+			}
+			left -= refund
+		}
+
+		if !reflect.DeepEqual(amounts(refunded), parts) {
+			t.Errorf("Expected full refund of %v got %v", parts, amounts(refunded))
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package money
+
+import (
+	"maps"
+	"sync"
+	"sync/atomic"
+)
+
+// The active currency registry is an immutable snapshot swapped atomically on every
+// change, so lookups never take a lock. Changes are serialized by registryMu and copy
+// the map of the snapshot, as registrations are rare compared to lookups. Currencies
+// are shared between snapshots and replaced rather than modified, so a registered
+// Currency stays the one returned by lookups until its code is registered again.
+var (
+	registryMu sync.Mutex
+	registry   atomic.Pointer[Currencies]
+)
+
+func init() {
+	cs := cloneCurrencies(currencies, nil)
+	registry.Store(&cs)
+}
+
+// loadRegistry returns the active registry snapshot, which must not be modified.
+func loadRegistry() Currencies {
+	return *registry.Load()
+}
+
+// updateRegistry replaces the active registry by the result of f, which is given a
+// copy of the active registry it may add entries to, but must not modify the Currency
+// of existing entries.
+func updateRegistry(f func(cs Currencies) Currencies) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	cs := f(maps.Clone(loadRegistry()))
+	registry.Store(&cs)
+}
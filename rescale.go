@@ -0,0 +1,47 @@
+package money
+
+import "fmt"
+
+// Rescale returns new Money holding the value of Self with targetFraction fraction digits,
+// e.g. to carry amounts of a currency with more precision than its minor unit. Dropped
+// digits are rounded with given rounding mode. The Currency of the result is a copy with
+// Fraction set to targetFraction. It returns ErrInvalidAmount for a negative fraction and
+// ErrOverflow when the amount doesn't fit into Amount.
+func (m *Money) Rescale(targetFraction int, mode RoundingMode) (*Money, error) {
+	if targetFraction < 0 {
+		return nil, fmt.Errorf("%w: negative fraction %d", ErrInvalidAmount, targetFraction)
+	}
+
+	c := *m.Currency.get()
+	a, err := roundAmount(m.Amount, c.Fraction, targetFraction, mode)
+	if err != nil {
+		return nil, err
+	}
+	c.Fraction = targetFraction
+
+	return &Money{Amount: a, Currency: &c}, nil
+}
+
+// NewFromScale creates and returns new instance of Money from an amount stored with
+// fraction digits other than the ones of the Currency, to reinterpret legacy data stored
+// at the wrong scale, e.g. JPY stored with 2 implied decimals as 150000 for ¥1500.
+// It returns ErrPrecisionLoss when non-zero digits would be dropped and ErrOverflow when
+// the amount doesn't fit into Amount.
+func NewFromScale(amount int64, fraction int, code string) (*Money, error) {
+	if fraction < 0 {
+		return nil, fmt.Errorf("%w: negative fraction %d", ErrInvalidAmount, fraction)
+	}
+
+	m, err := NewWithOptions(0, code)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := rescaleAmount(Amount(amount), fraction, m.Currency.Fraction)
+	if err != nil {
+		return nil, err
+	}
+	m.Amount = a
+
+	return m, nil
+}
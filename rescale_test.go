@@ -0,0 +1,72 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMoney_Rescale(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		fraction int
+		mode     RoundingMode
+		expected string
+		err      error
+	}{
+		{1099, USD, 4, RoundHalfUp, "$10.9900", nil},
+		{1099, USD, 1, RoundHalfUp, "$11.0", nil},
+		{1095, USD, 1, RoundHalfEven, "$11.0", nil},
+		{-1095, USD, 1, RoundDown, "-$10.9", nil},
+		{1500, JPY, 0, RoundHalfUp, "¥1,500", nil},
+		{math.MaxInt64, USD, 3, RoundHalfUp, "", ErrOverflow},
+		{1, USD, -1, RoundHalfUp, "", ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, tc.code)
+		r, err := m.Rescale(tc.fraction, tc.mode)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v rescaling %d %s got %v", tc.err, tc.amount, tc.code, err)
+			continue
+		}
+
+		if err == nil && r.Display() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r.Display())
+		}
+
+		if m.Currency.Fraction != GetCurrency(tc.code).Fraction {
+			t.Errorf("Expected Currency of %s to be unchanged got %+v", tc.code, m.Currency)
+		}
+	}
+}
+
+func TestNewFromScale(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		fraction int
+		code     string
+		expected Amount
+		err      error
+	}{
+		{150000, 2, JPY, 1500, nil},
+		{150050, 2, JPY, 0, ErrPrecisionLoss},
+		{1099, 3, KWD, 1099, nil},
+		{1099, 0, USD, 109900, nil},
+		{math.MaxInt64, 0, USD, 0, ErrOverflow},
+		{0, 25, USD, 0, nil},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromScale(tc.amount, tc.fraction, tc.code)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %d with %d decimals got %v", tc.err, tc.amount, tc.fraction, err)
+			continue
+		}
+
+		if err == nil && m.Amount != tc.expected {
+			t.Errorf("Expected %d got %d", tc.expected, m.Amount)
+		}
+	}
+}
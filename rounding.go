@@ -0,0 +1,135 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"sync/atomic"
+)
+
+// RoundingMode specifies how a value falling between two minor units is rounded.
+type RoundingMode int
+
+// Rounding modes supported by operations that may produce fractions of a minor unit.
+const (
+	// RoundHalfUp rounds to the nearest neighbour, ties away from zero.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfDown rounds to the nearest neighbour, ties towards zero.
+	RoundHalfDown
+	// RoundHalfEven rounds to the nearest neighbour, ties to the even one (banker's rounding).
+	RoundHalfEven
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds towards zero.
+	RoundDown
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+)
+
+var roundingModeNames = [...]string{
+	RoundHalfUp:   "half_up",
+	RoundHalfDown: "half_down",
+	RoundHalfEven: "half_even",
+	RoundUp:       "up",
+	RoundDown:     "down",
+	RoundCeiling:  "ceiling",
+	RoundFloor:    "floor",
+}
+
+// String returns the name of the rounding mode.
+func (rm RoundingMode) String() string {
+	if rm < 0 || int(rm) >= len(roundingModeNames) {
+		return "unknown"
+	}
+
+	return roundingModeNames[rm]
+}
+
+var defaultRounding atomic.Int64
+
+// SetDefaultRounding sets the RoundingMode used by operations which don't take one,
+// like NewFromFloat and Divide. It is RoundHalfUp unless set. It is safe to call
+// concurrently with operations, but is meant to be called once during program
+// initialization.
+func SetDefaultRounding(mode RoundingMode) {
+	defaultRounding.Store(int64(mode))
+}
+
+// GetDefaultRounding returns the RoundingMode used by operations which don't take one.
+func GetDefaultRounding() RoundingMode {
+	return RoundingMode(defaultRounding.Load())
+}
+
+// roundUp reports whether a truncated quotient has to be moved one unit away from zero.
+// cmp is the comparison of the remainder with half of the divisor, odd tells whether
+// the truncated quotient is odd and neg whether the exact quotient is negative.
+func (rm RoundingMode) roundUp(cmp int, odd, neg bool) bool {
+	switch rm {
+	case RoundHalfUp:
+		return cmp >= 0
+	case RoundHalfDown:
+		return cmp > 0
+	case RoundHalfEven:
+		return cmp > 0 || (cmp == 0 && odd)
+	case RoundUp:
+		return true
+	case RoundCeiling:
+		return !neg
+	case RoundFloor:
+		return neg
+	}
+
+	return false
+}
+
+// roundFloat rounds f to an integer using given rounding mode.
+func roundFloat(f float64, mode RoundingMode) float64 {
+	t := math.Trunc(f)
+	if t == f {
+		return f
+	}
+
+	d := math.Abs(f - t)
+	cmp := 0
+	switch {
+	case d < 0.5:
+		cmp = -1
+	case d > 0.5:
+		cmp = 1
+	}
+
+	if mode.roundUp(cmp, math.Mod(t, 2) != 0, f < 0) {
+		return t + math.Copysign(1, f)
+	}
+
+	return t
+}
+
+// roundQuo returns n / d rounded to an integer using given rounding mode.
+func roundQuo(n, d *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	neg := n.Sign()*d.Sign() < 0
+	r2 := new(big.Int).Abs(r)
+	r2.Lsh(r2, 1)
+	cmp := r2.Cmp(new(big.Int).Abs(d))
+
+	if mode.roundUp(cmp, q.Bit(0) == 1, neg) {
+		if neg {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return q
+}
+
+// scale returns 10 raised to the fraction of the Currency.
+func scale(fraction int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fraction)), nil)
+}
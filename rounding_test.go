@@ -0,0 +1,107 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRoundQuo(t *testing.T) {
+	tcs := []struct {
+		n, d     int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{25, 10, RoundHalfUp, 3},
+		{-25, 10, RoundHalfUp, -3},
+		{24, 10, RoundHalfUp, 2},
+		{25, 10, RoundHalfDown, 2},
+		{26, 10, RoundHalfDown, 3},
+		{-25, 10, RoundHalfDown, -2},
+		{25, 10, RoundHalfEven, 2},
+		{35, 10, RoundHalfEven, 4},
+		{-35, 10, RoundHalfEven, -4},
+		{21, 10, RoundUp, 3},
+		{-21, 10, RoundUp, -3},
+		{29, 10, RoundDown, 2},
+		{-29, 10, RoundDown, -2},
+		{21, 10, RoundCeiling, 3},
+		{-29, 10, RoundCeiling, -2},
+		{29, 10, RoundFloor, 2},
+		{-21, 10, RoundFloor, -3},
+		{21, -10, RoundFloor, -3},
+		{30, 10, RoundUp, 3},
+	}
+
+	for _, tc := range tcs {
+		r := roundQuo(big.NewInt(tc.n), big.NewInt(tc.d), tc.mode)
+
+		if r.Int64() != tc.expected {
+			t.Errorf("Expected %d/%d rounded %s to be %d got %d", tc.n, tc.d, tc.mode, tc.expected, r)
+		}
+	}
+}
+
+func TestRoundingMode_String(t *testing.T) {
+	if s := RoundHalfEven.String(); s != "half_even" {
+		t.Errorf("Expected %s got %s", "half_even", s)
+	}
+
+	if s := RoundingMode(42).String(); s != "unknown" {
+		t.Errorf("Expected %s got %s", "unknown", s)
+	}
+}
+
+func TestRoundFloat(t *testing.T) {
+	modes := []RoundingMode{RoundHalfUp, RoundHalfDown, RoundHalfEven, RoundUp, RoundDown, RoundCeiling, RoundFloor}
+
+	// Results of each value in the order of modes above.
+	tcs := []struct {
+		f        float64
+		expected []float64
+	}{
+		{2.5, []float64{3, 2, 2, 3, 2, 3, 2}},
+		{3.5, []float64{4, 3, 4, 4, 3, 4, 3}},
+		{-2.5, []float64{-3, -2, -2, -3, -2, -2, -3}},
+		{2.4, []float64{2, 2, 2, 3, 2, 3, 2}},
+		{-2.6, []float64{-3, -3, -3, -3, -2, -2, -3}},
+		{7, []float64{7, 7, 7, 7, 7, 7, 7}},
+	}
+
+	for _, tc := range tcs {
+		for i, mode := range modes {
+			if r := roundFloat(tc.f, mode); r != tc.expected[i] {
+				t.Errorf("Expected %v rounded %s to be %v got %v", tc.f, mode, tc.expected[i], r)
+			}
+		}
+	}
+}
+
+func TestSetDefaultRounding(t *testing.T) {
+	defer SetDefaultRounding(RoundHalfUp)
+
+	if m := GetDefaultRounding(); m != RoundHalfUp {
+		t.Errorf("Expected default %s got %s", RoundHalfUp, m)
+	}
+
+	tcs := []struct {
+		mode            RoundingMode
+		float, quotient Amount
+	}{
+		{RoundHalfUp, 3, 3},
+		{RoundHalfEven, 2, 2},
+		{RoundFloor, 2, 2},
+		{RoundCeiling, 3, 3},
+	}
+
+	for _, tc := range tcs {
+		SetDefaultRounding(tc.mode)
+
+		if m := NewFromFloat(0.025, EUR); m.Amount != tc.float {
+			t.Errorf("Expected %d rounding %s got %d", tc.float, tc.mode, m.Amount)
+		}
+
+		if m, err := New(5, EUR).Divide(2); err != nil || m.Amount != tc.quotient {
+			t.Errorf("Expected %d rounding %s got %v (%v)", tc.quotient, tc.mode, m, err)
+		}
+	}
+}
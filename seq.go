@@ -0,0 +1,74 @@
+package money
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SplitSeq returns an iterator over the parties of Split, yielding the index and the
+// Money of each party lazily instead of allocating all of them up front.
+// Parties are computed exactly as by Split.
+func (m *Money) SplitSeq(n int) (iter.Seq2[int, *Money], error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: split must be higher than zero", ErrInvalidRatio)
+	}
+
+	a := calcDivide(m.Amount, int64(n))
+	l := mutate.calc.absolute(calcModulus(m.Amount, int64(n)))
+
+	v := Amount(1)
+	if m.Amount < 0 {
+		v = -1
+	}
+
+	return func(yield func(int, *Money) bool) {
+		for i := 0; i < n; i++ {
+			p := &Money{Amount: a, Currency: m.Currency}
+			if Amount(i) < l {
+				p.Amount = mutate.calc.add(p.Amount, v)
+			}
+
+			if !yield(i, p) {
+				return
+			}
+		}
+	}, nil
+}
+
+// AllocateSeq returns an iterator over the parties of Allocate, yielding the index and
+// the Money of each party lazily instead of allocating all of them up front.
+// Parties are computed exactly as by Allocate.
+func (m *Money) AllocateSeq(rs ...int) (iter.Seq2[int, *Money], error) {
+	sum, err := ratioSum(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The leftover is known only once all parties are computed.
+	var lo Amount
+	if sum != 0 {
+		lo = m.Amount
+		for _, r := range rs {
+			lo -= calcAllocate(m.Amount, uint64(r), uint64(sum))
+		}
+	}
+
+	sub := Amount(1)
+	if lo < 0 {
+		sub = -sub
+	}
+	lo = mutate.calc.absolute(lo)
+
+	return func(yield func(int, *Money) bool) {
+		for i, r := range rs {
+			p := &Money{Amount: calcAllocate(m.Amount, uint64(r), uint64(sum)), Currency: m.Currency}
+			if Amount(i) < lo {
+				p.Amount = mutate.calc.add(p.Amount, sub)
+			}
+
+			if !yield(i, p) {
+				return
+			}
+		}
+	}, nil
+}
@@ -0,0 +1,143 @@
+package money
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestMoney_SplitSeq(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		n      int
+	}{
+		{100, 3},
+		{100, 4},
+		{5, 3},
+		{-101, 4},
+		{-2, 3},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		split, _ := m.Split(tc.n)
+		seq, err := m.SplitSeq(tc.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var parts []*Money
+		for i, p := range seq {
+			if i != len(parts) {
+				t.Errorf("Expected index %d got %d", len(parts), i)
+			}
+			parts = append(parts, p)
+		}
+
+		if !reflect.DeepEqual(amounts(parts), amounts(split)) {
+			t.Errorf("Expected split of %d to be %v got %v", tc.amount, amounts(split), amounts(parts))
+		}
+	}
+
+	if _, err := New(100, EUR).SplitSeq(0); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_AllocateSeq(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		ratios []int
+	}{
+		{100, []int{50, 50}},
+		{100, []int{30, 30, 30}},
+		{5, []int{50, 25, 25}},
+		{-5, []int{50, 25, 25}},
+		{0, []int{0, 0}},
+		{10, []int{0, 0}},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		allocated, _ := m.Allocate(tc.ratios...)
+		seq, err := m.AllocateSeq(tc.ratios...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var parts []*Money
+		for _, p := range seq {
+			parts = append(parts, p)
+		}
+
+		if !reflect.DeepEqual(amounts(parts), amounts(allocated)) {
+			t.Errorf("Expected allocation of %d to be %v got %v", tc.amount, amounts(allocated), amounts(parts))
+		}
+	}
+
+	if _, err := New(100, EUR).AllocateSeq(); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_SplitSeq_Break(t *testing.T) {
+	seq, _ := New(100, EUR).SplitSeq(100000)
+
+	n := 0
+	for range seq {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+
+	if n != 3 {
+		t.Errorf("Expected to stop after %d parties got %d", 3, n)
+	}
+}
+
+// nearestCalculator divides and allocates to the nearest amount instead of truncating.
+type nearestCalculator struct {
+	Calculator
+}
+
+func (nearestCalculator) Divide(a Amount, d int64) Amount {
+	return Amount(math.Round(float64(a) / float64(d)))
+}
+
+func (c nearestCalculator) Modulus(a Amount, d int64) Amount {
+	return a - c.Divide(a, d)*Amount(d)
+}
+
+func (nearestCalculator) Allocate(a Amount, r, s uint64) Amount {
+	return Amount(math.Round(float64(a) * float64(r) / float64(s)))
+}
+
+func TestMoney_Seq_Calculator(t *testing.T) {
+	defer SetCalculator(nil)
+	SetCalculator(nearestCalculator{DefaultCalculator()})
+
+	m := New(200, EUR)
+
+	split, _ := m.Split(3)
+	seq, _ := m.SplitSeq(3)
+	var parts []*Money
+	for _, p := range seq {
+		parts = append(parts, p)
+	}
+
+	if !reflect.DeepEqual(amounts(parts), amounts(split)) {
+		t.Errorf("Expected split to be %v got %v", amounts(split), amounts(parts))
+	}
+
+	allocated, _ := m.Allocate(1, 1, 1)
+	aseq, _ := m.AllocateSeq(1, 1, 1)
+	parts = nil
+	for _, p := range aseq {
+		parts = append(parts, p)
+	}
+
+	if !reflect.DeepEqual(amounts(parts), amounts(allocated)) {
+		t.Errorf("Expected allocation to be %v got %v", amounts(allocated), amounts(parts))
+	}
+}
@@ -0,0 +1,19 @@
+package money
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer, so Money is logged as a group of
+// amount, currency and display attributes.
+func (m *Money) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+
+	c := m.Currency.get()
+
+	return slog.GroupValue(
+		slog.Int64("amount", int64(m.Amount)),
+		slog.String("currency", c.Code),
+		slog.String("display", c.Formatter().Format(int64(m.Amount))),
+	)
+}
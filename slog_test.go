@@ -0,0 +1,28 @@
+package money
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestMoney_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	logger.Info("charged", "price", New(1099, EUR))
+	expected := `"price":{"amount":1099,"currency":"EUR","display":"€10.99"}`
+
+	if !strings.Contains(buf.String(), expected) {
+		t.Errorf("Expected log to contain %s got %s", expected, buf.String())
+	}
+
+	buf.Reset()
+	var m *Money
+	logger.Info("charged", "price", m)
+
+	if !strings.Contains(buf.String(), `"price":null`) {
+		t.Errorf("Expected nil Money to be logged as null got %s", buf.String())
+	}
+}
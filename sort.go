@@ -0,0 +1,110 @@
+package money
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+)
+
+// Compare returns an ordering of two Money compatible with slices.SortFunc.
+// Money is ordered by currency code first and by amount second, so it defines
+// a total order even over mixed currencies. Nil Money is ordered first, followed
+// by Money without Currency.
+func Compare(a, b *Money) int {
+	if a == nil || b == nil {
+		return cmp.Compare(boolToInt(a != nil), boolToInt(b != nil))
+	}
+
+	if !a.SameCurrency(b) {
+		if c := cmp.Compare(boolToInt(a.Currency != nil), boolToInt(b.Currency != nil)); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(canonicalCode(codeOf(a.Currency)), canonicalCode(codeOf(b.Currency))); c != 0 {
+			return c
+		}
+	}
+
+	return a.compare(b)
+}
+
+// SortAscending sorts given Money in place from the smallest to the largest value.
+// The order of equal values is kept. It returns ErrCurrencyMismatch without
+// sorting when the Money don't share the same Currency. Nil values are ordered first.
+func SortAscending(ms []*Money) error {
+	if _, err := firstOfCurrency(ms); err != nil && !errors.Is(err, ErrNoValues) {
+		return err
+	}
+
+	slices.SortStableFunc(ms, Compare)
+
+	return nil
+}
+
+// SortDescending sorts given Money in place from the largest to the smallest value.
+// The order of equal values is kept. It returns ErrCurrencyMismatch without
+// sorting when the Money don't share the same Currency. Nil values are ordered last.
+func SortDescending(ms []*Money) error {
+	if _, err := firstOfCurrency(ms); err != nil && !errors.Is(err, ErrNoValues) {
+		return err
+	}
+
+	slices.SortStableFunc(ms, func(a, b *Money) int { return Compare(b, a) })
+
+	return nil
+}
+
+// CompareAmounts returns an ordering of two Money by amount alone, compatible with
+// slices.SortFunc. It doesn't look at the currencies, so check them first, e.g. with
+// NewByAmount. Nil Money is ordered first.
+func CompareAmounts(a, b *Money) int {
+	if a == nil || b == nil {
+		return cmp.Compare(boolToInt(a != nil), boolToInt(b != nil))
+	}
+
+	return cmp.Compare(a.Amount, b.Amount)
+}
+
+// ByAmount implements sort.Interface over Money of a single Currency, ordered by amount
+// with nil Money first. Create it with NewByAmount to check the currencies once, so
+// sorting needs no error handling:
+//
+//	b, err := NewByAmount(invoices)
+//	if err != nil {
+//		return err
+//	}
+//	sort.Sort(sort.Reverse(b))
+type ByAmount []*Money
+
+// NewByAmount returns ByAmount over given Money, sharing its backing array. It returns
+// ErrCurrencyMismatch when the Money don't share the same Currency.
+func NewByAmount(ms []*Money) (ByAmount, error) {
+	if _, err := firstOfCurrency(ms); err != nil && !errors.Is(err, ErrNoValues) {
+		return nil, err
+	}
+
+	return ByAmount(ms), nil
+}
+
+// Len implements sort.Interface.
+func (b ByAmount) Len() int {
+	return len(b)
+}
+
+// Less implements sort.Interface.
+func (b ByAmount) Less(i, j int) bool {
+	return CompareAmounts(b[i], b[j]) < 0
+}
+
+// Swap implements sort.Interface.
+func (b ByAmount) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,116 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func amounts(ms []*Money) []int64 {
+	as := make([]int64, 0, len(ms))
+	for _, m := range ms {
+		if m == nil {
+			as = append(as, 0)
+			continue
+		}
+		as = append(as, int64(m.Amount))
+	}
+
+	return as
+}
+
+func TestCompare(t *testing.T) {
+	ms := []*Money{New(300, USD), New(200, EUR), nil, New(100, USD), New(400, EUR)}
+	slices.SortFunc(ms, Compare)
+
+	if ms[0] != nil || ms[1].Currency.Code != EUR || ms[3].Currency.Code != USD {
+		t.Errorf("Expected nil first and currencies ordered by code got %v", ms)
+	}
+
+	if as := amounts(ms[1:]); !reflect.DeepEqual(as, []int64{200, 400, 100, 300}) {
+		t.Errorf("Expected %v got %v", []int64{200, 400, 100, 300}, as)
+	}
+
+	ms = []*Money{New(100, USD), {Amount: 2}, nil, {Amount: 1}, {Amount: 5, Currency: &Currency{Code: "eur"}}}
+	slices.SortFunc(ms, Compare)
+
+	if ms[0] != nil || ms[1].Currency != nil || ms[2].Currency != nil || ms[3].Currency.Code != "eur" {
+		t.Errorf("Expected nil first and Money without Currency next got %v", ms)
+	}
+
+	if as := amounts(ms[1:]); !reflect.DeepEqual(as, []int64{1, 2, 5, 100}) {
+		t.Errorf("Expected %v got %v", []int64{1, 2, 5, 100}, as)
+	}
+}
+
+func TestSortAscending(t *testing.T) {
+	ms := moneys(EUR, 300, -100, 200, 0)
+
+	if err := SortAscending(ms); err != nil {
+		t.Fatal(err)
+	}
+
+	if as := amounts(ms); !reflect.DeepEqual(as, []int64{-100, 0, 200, 300}) {
+		t.Errorf("Expected %v got %v", []int64{-100, 0, 200, 300}, as)
+	}
+
+	if err := SortAscending(nil); err != nil {
+		t.Errorf("Expected no error for empty slice got %v", err)
+	}
+}
+
+func TestSortDescending(t *testing.T) {
+	ms := moneys(EUR, 300, -100, 200, 0)
+
+	if err := SortDescending(ms); err != nil {
+		t.Fatal(err)
+	}
+
+	if as := amounts(ms); !reflect.DeepEqual(as, []int64{300, 200, 0, -100}) {
+		t.Errorf("Expected %v got %v", []int64{300, 200, 0, -100}, as)
+	}
+
+	mixed := []*Money{New(2, EUR), New(1, USD)}
+	if err := SortDescending(mixed); !errors.Is(err, ErrCurrencyMismatch) || mixed[0].Amount != 2 {
+		t.Errorf("Expected %v and unsorted slice got %v %v", ErrCurrencyMismatch, err, mixed)
+	}
+}
+
+func TestCompareAmounts(t *testing.T) {
+	ms := []*Money{New(300, USD), nil, New(-100, USD), New(200, USD)}
+	slices.SortFunc(ms, CompareAmounts)
+
+	if ms[0] != nil || !reflect.DeepEqual(amounts(ms[1:]), []int64{-100, 200, 300}) {
+		t.Errorf("Expected nil first and %v got %v", []int64{-100, 200, 300}, ms)
+	}
+}
+
+func TestByAmount(t *testing.T) {
+	ms := moneys(EUR, 300, -100, 200, 0)
+	ms = append(ms, nil)
+
+	b, err := NewByAmount(ms)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Sort(b)
+	if ms[0] != nil || !reflect.DeepEqual(amounts(ms[1:]), []int64{-100, 0, 200, 300}) {
+		t.Errorf("Expected nil first and %v got %v", []int64{-100, 0, 200, 300}, ms)
+	}
+
+	sort.Sort(sort.Reverse(b))
+	if !reflect.DeepEqual(amounts(ms[:4]), []int64{300, 200, 0, -100}) || ms[4] != nil {
+		t.Errorf("Expected %v and nil last got %v", []int64{300, 200, 0, -100}, ms)
+	}
+
+	if b, err := NewByAmount(nil); err != nil || b.Len() != 0 {
+		t.Errorf("Expected empty ByAmount got %v (%v)", b, err)
+	}
+
+	if _, err := NewByAmount([]*Money{New(2, EUR), New(1, USD)}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
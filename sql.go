@@ -0,0 +1,168 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// SQLFormat defines how Money is stored in a database column by Money.Value.
+type SQLFormat int32
+
+const (
+	// SQLComposite stores Money as a string of its amount in minor units and currency
+	// code like "1099|USD". It is the default.
+	SQLComposite SQLFormat = iota
+	// SQLJSON stores Money as JSON like {"amount":1099,"currency":"USD"}, e.g. for
+	// JSON columns.
+	SQLJSON
+	// SQLAmount stores only the amount in minor units as int64, e.g. for tables keeping
+	// the currency code in another column or a single currency per table. Money scanned
+	// from an amount keeps the Currency it had before, which has to be set up front.
+	SQLAmount
+)
+
+var sqlFormat atomic.Int32
+
+// SetSQLFormat sets the SQLFormat used by Money.Value. It is safe to call concurrently,
+// but is meant to be called once during program initialization.
+func SetSQLFormat(f SQLFormat) {
+	sqlFormat.Store(int32(f))
+}
+
+// GetSQLFormat returns the SQLFormat used by Money.Value.
+func GetSQLFormat() SQLFormat {
+	return SQLFormat(sqlFormat.Load())
+}
+
+// Value implements driver.Valuer, storing Money in the active SQLFormat and nil Money
+// as NULL. It returns ErrNilCurrency for Money without Currency unless only the amount
+// is stored.
+func (m *Money) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	f := GetSQLFormat()
+	if f == SQLAmount {
+		return int64(m.Amount), nil
+	}
+
+	if m.Currency == nil {
+		return nil, ErrNilCurrency
+	}
+
+	if f == SQLJSON {
+		b, err := json.Marshal(m.ToValue())
+		if err != nil {
+			return nil, err
+		}
+
+		return string(b), nil
+	}
+
+	return m.Amount.String() + "|" + canonicalCode(m.Currency.Code), nil
+}
+
+// Scan implements sql.Scanner. It accepts all of the SQLFormat representations
+// regardless of the active one, so a column can be migrated between them. Scanning an
+// amount alone keeps the Currency of the Money and fails with ErrNilCurrency when it
+// has none. Scanning NULL fails with ErrNilMoney, use NullMoney for nullable columns.
+// Malformed values are reported as ParseError and unknown codes as rejected by the
+// UnknownCodePolicy.
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		return fmt.Errorf("%w: can't scan NULL, use NullMoney", ErrNilMoney)
+	case int64:
+		return m.scanAmount(v)
+	case []byte:
+		return m.scanText(string(v))
+	case string:
+		return m.scanText(v)
+	}
+
+	return fmt.Errorf("%w: can't scan %T into Money", ErrInvalidAmount, src)
+}
+
+func (m *Money) scanText(s string) error {
+	if strings.HasPrefix(s, "{") {
+		var v Value
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return &ParseError{Input: s, Err: ErrInvalidAmount}
+		}
+
+		return m.set(int64(v.Amount), v.Code)
+	}
+
+	as, code, composite := strings.Cut(s, "|")
+	a, err := strconv.ParseInt(as, 10, 64)
+	if err != nil {
+		return &ParseError{Input: s, Err: ErrInvalidAmount}
+	}
+
+	if !composite {
+		return m.scanAmount(a)
+	}
+
+	return m.set(a, code)
+}
+
+func (m *Money) scanAmount(a int64) error {
+	if m.Currency == nil {
+		return ErrNilCurrency
+	}
+	m.Amount = Amount(a)
+
+	return nil
+}
+
+func (m *Money) set(a int64, code string) error {
+	if code == "" {
+		return ErrNilCurrency
+	}
+
+	nm, err := NewWithOptions(a, code)
+	if err != nil {
+		return err
+	}
+	*m = *nm
+
+	return nil
+}
+
+// NullMoney represents Money that may be NULL, like sql.NullString. Money is stored in
+// the active SQLFormat when Valid is true.
+type NullMoney struct {
+	Money Money
+	Valid bool
+}
+
+// Scan implements sql.Scanner. NULL sets Valid to false and keeps Money as is, so the
+// Currency set up front for SQLAmount columns is kept too.
+func (n *NullMoney) Scan(src any) error {
+	if src == nil {
+		n.Valid = false
+		return nil
+	}
+
+	if err := n.Money.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullMoney) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Money.Value()
+}
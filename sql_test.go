@@ -0,0 +1,129 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*Money)(nil)
+	_ driver.Valuer = (*Money)(nil)
+	_ sql.Scanner   = (*NullMoney)(nil)
+	_ driver.Valuer = NullMoney{}
+)
+
+func TestMoney_Value(t *testing.T) {
+	defer SetSQLFormat(SQLComposite)
+
+	m := New(-1099, "usd")
+	tcs := []struct {
+		format   SQLFormat
+		expected driver.Value
+	}{
+		{SQLComposite, "-1099|USD"},
+		{SQLJSON, `{"amount":-1099,"currency":"USD"}`},
+		{SQLAmount, int64(-1099)},
+	}
+
+	for _, tc := range tcs {
+		SetSQLFormat(tc.format)
+		if v, err := m.Value(); err != nil || v != tc.expected {
+			t.Errorf("Expected %v got %v (%v)", tc.expected, v, err)
+		}
+
+		// Every format scans back into the same Money.
+		v, _ := m.Value()
+		r := New(0, USD)
+		if err := r.Scan(v); err != nil || !r.SameCurrency(m) || r.Amount != m.Amount {
+			t.Errorf("Expected %v to scan into %v got %v (%v)", v, m, r, err)
+		}
+	}
+
+	SetSQLFormat(SQLComposite)
+	if _, err := (&Money{Amount: 1}).Value(); !errors.Is(err, ErrNilCurrency) {
+		t.Errorf("Expected %v got %v", ErrNilCurrency, err)
+	}
+
+	if v, err := (*Money)(nil).Value(); err != nil || v != nil {
+		t.Errorf("Expected NULL got %v (%v)", v, err)
+	}
+}
+
+func TestMoney_Scan(t *testing.T) {
+	tcs := []struct {
+		src      any
+		expected *Money
+	}{
+		{"1099|USD", New(1099, USD)},
+		{[]byte("-5|eur"), New(-5, EUR)},
+		{`{"amount":12,"currency":"JPY"}`, New(12, JPY)},
+		{[]byte(`{"amount":0,"currency":"GBP"}`), New(0, GBP)},
+		{int64(42), New(42, CHF)},
+		{[]byte("42"), New(42, CHF)},
+	}
+
+	for _, tc := range tcs {
+		m := New(0, CHF)
+		if err := m.Scan(tc.src); err != nil || !m.SameCurrency(tc.expected) || m.Amount != tc.expected.Amount {
+			t.Errorf("Expected %v for %v got %v (%v)", tc.expected, tc.src, m, err)
+		}
+	}
+}
+
+func TestMoney_ScanErrors(t *testing.T) {
+	tcs := []struct {
+		src any
+		err error
+	}{
+		{nil, ErrNilMoney},
+		{1.5, ErrInvalidAmount},
+		{"1.5|USD", ErrInvalidAmount},
+		{"|USD", ErrInvalidAmount},
+		{"99999999999999999999|USD", ErrInvalidAmount},
+		{"1|", ErrNilCurrency},
+		{`{"amount":1.5,"currency":"USD"}`, ErrInvalidAmount},
+		{int64(1), ErrNilCurrency},
+		{"1", ErrNilCurrency},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := m.Scan(tc.src); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %v got %v", tc.err, tc.src, err)
+		}
+	}
+
+	defer SetUnknownCodePolicy(nil)
+	SetUnknownCodePolicy(UnknownCodeError())
+
+	var m Money
+	if err := m.Scan("1|XYZ"); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+}
+
+func TestNullMoney(t *testing.T) {
+	n := NullMoney{Money: *New(0, EUR)}
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Expected invalid NullMoney got %v (%v)", n, err)
+	}
+
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("Expected NULL got %v (%v)", v, err)
+	}
+
+	// The Currency is kept for amount only columns.
+	if err := n.Scan(int64(250)); err != nil || !n.Valid || n.Money.Amount != 250 || n.Money.Currency.Code != EUR {
+		t.Errorf("Expected valid NullMoney of %d %s got %v (%v)", 250, EUR, n, err)
+	}
+
+	if v, err := n.Value(); err != nil || v != "250|EUR" {
+		t.Errorf("Expected %q got %v (%v)", "250|EUR", v, err)
+	}
+
+	if err := n.Scan("x"); err == nil || n.Valid {
+		t.Errorf("Expected error and invalid NullMoney got %v (%v)", n, err)
+	}
+}
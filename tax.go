@@ -0,0 +1,72 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// Percent returns new Money with p percent of Self, e.g. 7.5 for 7.5%, rounded with given
+// rounding mode. The percentage is taken as its shortest decimal representation and the
+// result is computed exactly before rounding. It returns ErrInvalidRatio for NaN and
+// infinities and ErrOverflow, or panics or saturates depending on the active Policy,
+// when the result doesn't fit into Amount.
+func (m *Money) Percent(p float64, mode RoundingMode) (*Money, error) {
+	if math.IsNaN(p) || math.IsInf(p, 0) {
+		return nil, fmt.Errorf("%w: percentage %v", ErrInvalidRatio, p)
+	}
+
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(p, 'g', -1, 64))
+
+	return m.MultiplyRat(r.Quo(r, big.NewRat(100, 1)), mode)
+}
+
+// AddTax returns new Money with the tax of given rate, e.g. 1/5 for 20% VAT, added to the
+// net amount of Self. The tax is rounded with the default RoundingMode, see
+// SetDefaultRounding. It returns ErrInvalidRatio for a nil or negative rate.
+func (m *Money) AddTax(rate *big.Rat) (*Money, error) {
+	if err := checkTaxRate(rate); err != nil {
+		return nil, err
+	}
+
+	tax, err := m.MultiplyRat(rate, GetDefaultRounding())
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Add(tax)
+}
+
+// ExtractTax returns new Money with the tax of given rate included in the gross amount of
+// Self, e.g. 20 of 120 at 1/5. See SplitNetTax for the rules.
+func (m *Money) ExtractTax(rate *big.Rat) (*Money, error) {
+	_, tax, err := m.SplitNetTax(rate)
+	return tax, err
+}
+
+// SplitNetTax splits the gross amount of Self into its net amount and the tax of given
+// rate it includes. The tax is gross * rate / (1 + rate) rounded with the default
+// RoundingMode, see SetDefaultRounding, and net is the rest, so both always add up to
+// the gross amount. It returns ErrInvalidRatio for a nil or negative rate.
+func (m *Money) SplitNetTax(rate *big.Rat) (net, tax *Money, err error) {
+	if err := checkTaxRate(rate); err != nil {
+		return nil, nil, err
+	}
+
+	r := new(big.Rat).Add(rate, big.NewRat(1, 1))
+	if tax, err = m.MultiplyRat(r.Quo(rate, r), GetDefaultRounding()); err != nil {
+		return nil, nil, err
+	}
+
+	// The tax is at most the gross amount, so the difference never overflows.
+	return &Money{Amount: m.Amount - tax.Amount, Currency: m.Currency}, tax, nil
+}
+
+func checkTaxRate(rate *big.Rat) error {
+	if rate == nil || rate.Sign() < 0 {
+		return fmt.Errorf("%w: tax rate must not be negative", ErrInvalidRatio)
+	}
+
+	return nil
+}
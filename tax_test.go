@@ -0,0 +1,120 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestMoney_Percent(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		p        float64
+		mode     RoundingMode
+		expected Amount
+	}{
+		{10000, 7.5, RoundHalfUp, 750},
+		{1999, 10, RoundHalfUp, 200},
+		{1999, 10, RoundDown, 199},
+		{-1999, 10, RoundHalfUp, -200},
+		{100, 0.1, RoundHalfUp, 0},
+		{105, 10, RoundHalfEven, 10},
+		{100, 150, RoundHalfUp, 150},
+		{100, -20, RoundHalfUp, -20},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).Percent(tc.p, tc.mode)
+		if err != nil || r.Amount != tc.expected {
+			t.Errorf("Expected %v%% of %d to be %d got %v (%v)", tc.p, tc.amount, tc.expected, r, err)
+		}
+	}
+
+	for _, p := range []float64{math.NaN(), math.Inf(1)} {
+		if _, err := New(100, EUR).Percent(p, RoundHalfUp); !errors.Is(err, ErrInvalidRatio) {
+			t.Errorf("Expected %v for %v got %v", ErrInvalidRatio, p, err)
+		}
+	}
+
+	if _, err := New(int64(MaxAmount), EUR).Percent(200, RoundHalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestMoney_AddTax(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		rate     *big.Rat
+		expected Amount
+	}{
+		{10000, big.NewRat(1, 5), 12000},
+		{999, big.NewRat(19, 100), 1189},
+		{5, big.NewRat(1, 2), 8},
+		{-5, big.NewRat(1, 2), -8},
+		{100, new(big.Rat), 100},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).AddTax(tc.rate)
+		if err != nil || r.Amount != tc.expected {
+			t.Errorf("Expected %d with tax %v to be %d got %v (%v)", tc.amount, tc.rate, tc.expected, r, err)
+		}
+	}
+}
+
+func TestMoney_SplitNetTax(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		rate     *big.Rat
+		net, tax Amount
+	}{
+		{12000, big.NewRat(1, 5), 10000, 2000},
+		{1189, big.NewRat(19, 100), 999, 190},
+		{1000, big.NewRat(19, 100), 840, 160},
+		{-1000, big.NewRat(19, 100), -840, -160},
+		{1, big.NewRat(1, 5), 1, 0},
+		{100, new(big.Rat), 100, 0},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		net, tax, err := m.SplitNetTax(tc.rate)
+		if err != nil || net.Amount != tc.net || tax.Amount != tc.tax {
+			t.Errorf("Expected %d to split into %d and %d got %v and %v (%v)", tc.amount, tc.net, tc.tax, net, tax, err)
+			continue
+		}
+
+		if net.Amount+tax.Amount != m.Amount {
+			t.Errorf("Expected %v and %v to sum to %v", net, tax, m)
+		}
+
+		if et, err := m.ExtractTax(tc.rate); err != nil || et.Amount != tc.tax {
+			t.Errorf("Expected tax %d got %v (%v)", tc.tax, et, err)
+		}
+	}
+
+	for _, m := range []*Money{New(int64(MaxAmount), EUR), New(int64(MinAmount), EUR)} {
+		net, tax, err := m.SplitNetTax(big.NewRat(1, 5))
+		if err != nil || net.Amount+tax.Amount != m.Amount {
+			t.Errorf("Expected split of %v to sum up got %v and %v (%v)", m, net, tax, err)
+		}
+	}
+}
+
+func TestMoney_TaxErrors(t *testing.T) {
+	m := New(100, EUR)
+	for _, rate := range []*big.Rat{nil, big.NewRat(-1, 5)} {
+		if _, err := m.AddTax(rate); !errors.Is(err, ErrInvalidRatio) {
+			t.Errorf("Expected %v for %v got %v", ErrInvalidRatio, rate, err)
+		}
+
+		if _, _, err := m.SplitNetTax(rate); !errors.Is(err, ErrInvalidRatio) {
+			t.Errorf("Expected %v for %v got %v", ErrInvalidRatio, rate, err)
+		}
+	}
+
+	if _, err := (&Money{Amount: 1}).AddTax(big.NewRat(1, 5)); err == nil {
+		t.Error("Expected error for Money without Currency")
+	}
+}
@@ -0,0 +1,105 @@
+// Package tender applies split-tender payments on top of money.Money, e.g. a purchase
+// paid partly with a gift card, store credit and a card.
+package tender
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+// Tender is a means of payment offered against a total.
+type Tender struct {
+	Name string
+	// Amount is what the tender offers: the balance of a gift card or store credit, the
+	// amount to charge to a card, or the cash handed over.
+	Amount *money.Money
+	// Priority orders the tenders: lower values are applied first.
+	Priority int
+	// GivesChange is set for tenders whose excess over the total is paid back as change,
+	// like cash. Other tenders are only drawn down to what's left of the total.
+	GivesChange bool
+}
+
+// Application is the part of a Tender applied to the total.
+type Application struct {
+	Tender Tender
+	// Applied is the part of the Tender paying the total.
+	Applied *money.Money
+	// Change is the part of a Tender giving change that exceeds the total.
+	Change *money.Money
+}
+
+// Result is the outcome of Apply. The applications and Remaining always add up to the
+// total, and the tenders giving change add up to their Applied plus Change.
+type Result struct {
+	// Applications are in the order the tenders were applied.
+	Applications []Application
+	// Remaining is the part of the total no tender paid.
+	Remaining *money.Money
+	// Change is the sum of the change of all applications.
+	Change *money.Money
+}
+
+// IsPaid reports whether the tenders pay the whole total.
+func (r *Result) IsPaid() bool {
+	return r.Remaining.IsZero()
+}
+
+// Apply applies the tenders against the total by ascending Priority, tenders of equal
+// priority in the order given. Each tender pays as much as it offers of what's left of
+// the total. It returns money.ErrNilMoney, money.ErrInvalidAmount for negative Money
+// money.ErrCurrencyMismatch and money.ErrOverflow when the change doesn't fit into
+// money.Amount.
+func Apply(total *money.Money, tenders ...Tender) (*Result, error) {
+	if !total.IsValid() {
+		return nil, money.ErrNilMoney
+	}
+
+	if total.IsNegative() {
+		return nil, money.ErrInvalidAmount
+	}
+
+	for _, t := range tenders {
+		if !t.Amount.IsValid() {
+			return nil, fmt.Errorf("tender %q: %w", t.Name, money.ErrNilMoney)
+		}
+
+		if _, err := total.Compare(t.Amount); err != nil {
+			return nil, fmt.Errorf("tender %q: %w", t.Name, err)
+		}
+
+		if t.Amount.IsNegative() {
+			return nil, fmt.Errorf("tender %q: %w", t.Name, money.ErrInvalidAmount)
+		}
+	}
+
+	ordered := slices.Clone(tenders)
+	slices.SortStableFunc(ordered, func(a, b Tender) int { return cmp.Compare(a.Priority, b.Priority) })
+
+	zero := &money.Money{Currency: total.Currency}
+	r := &Result{Applications: make([]Application, 0, len(ordered)), Change: zero}
+	remaining := total.Amount
+
+	for _, t := range ordered {
+		applied := min(t.Amount.Amount, remaining)
+		remaining -= applied
+
+		a := Application{Tender: t, Applied: &money.Money{Amount: applied, Currency: total.Currency}, Change: zero}
+		if t.GivesChange {
+			a.Change = &money.Money{Amount: t.Amount.Amount - applied, Currency: total.Currency}
+
+			var err error
+			if r.Change, err = r.Change.Add(a.Change); err != nil {
+				return nil, err
+			}
+		}
+
+		r.Applications = append(r.Applications, a)
+	}
+	r.Remaining = &money.Money{Amount: remaining, Currency: total.Currency}
+
+	return r, nil
+}
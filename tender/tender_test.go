@@ -0,0 +1,109 @@
+package tender
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+func usd(amount int64) *money.Money {
+	return money.New(amount, money.USD)
+}
+
+func TestApply(t *testing.T) {
+	tcs := []struct {
+		total     int64
+		tenders   []Tender
+		applied   []money.Amount
+		changes   []money.Amount
+		remaining money.Amount
+		change    money.Amount
+	}{
+		{
+			10000,
+			[]Tender{
+				{Name: "card", Amount: usd(10000), Priority: 2},
+				{Name: "gift card", Amount: usd(2500)},
+				{Name: "store credit", Amount: usd(1000), Priority: 1},
+			},
+			[]money.Amount{2500, 1000, 6500},
+			[]money.Amount{0, 0, 0},
+			0, 0,
+		},
+		{
+			10000,
+			[]Tender{
+				{Name: "gift card", Amount: usd(15000)},
+				{Name: "cash", Amount: usd(2000), GivesChange: true},
+			},
+			[]money.Amount{10000, 0},
+			[]money.Amount{0, 2000},
+			0, 2000,
+		},
+		{
+			10000,
+			[]Tender{
+				{Name: "gift card", Amount: usd(2500)},
+				{Name: "cash", Amount: usd(10000), GivesChange: true},
+			},
+			[]money.Amount{2500, 7500},
+			[]money.Amount{0, 2500},
+			0, 2500,
+		},
+		{
+			10000,
+			[]Tender{
+				{Name: "gift card", Amount: usd(2500)},
+				{Name: "cash", Amount: usd(5000), GivesChange: true},
+			},
+			[]money.Amount{2500, 5000},
+			[]money.Amount{0, 0},
+			2500, 0,
+		},
+		{10000, nil, nil, nil, 10000, 0},
+	}
+
+	for _, tc := range tcs {
+		r, err := Apply(usd(tc.total), tc.tenders...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sum := r.Remaining.Amount
+		for i, a := range r.Applications {
+			sum += a.Applied.Amount
+			if a.Applied.Amount != tc.applied[i] || a.Change.Amount != tc.changes[i] {
+				t.Errorf("Expected %s to apply %d with %d change got %d with %d", a.Tender.Name, tc.applied[i], tc.changes[i], a.Applied.Amount, a.Change.Amount)
+			}
+		}
+
+		if r.Remaining.Amount != tc.remaining || r.Change.Amount != tc.change || r.IsPaid() != (tc.remaining == 0) {
+			t.Errorf("Expected %d remaining and %d change got %d and %d", tc.remaining, tc.change, r.Remaining.Amount, r.Change.Amount)
+		}
+
+		if sum != money.Amount(tc.total) {
+			t.Errorf("Expected applications to balance to %d got %d", tc.total, sum)
+		}
+	}
+}
+
+func TestApply_Errors(t *testing.T) {
+	tcs := []struct {
+		total   *money.Money
+		tenders []Tender
+		err     error
+	}{
+		{nil, nil, money.ErrNilMoney},
+		{usd(-1), nil, money.ErrInvalidAmount},
+		{usd(1), []Tender{{Name: "card"}}, money.ErrNilMoney},
+		{usd(1), []Tender{{Name: "card", Amount: usd(-1)}}, money.ErrInvalidAmount},
+		{usd(1), []Tender{{Name: "card", Amount: money.New(1, money.EUR)}}, money.ErrCurrencyMismatch},
+	}
+
+	for _, tc := range tcs {
+		if _, err := Apply(tc.total, tc.tenders...); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v got %v", tc.err, err)
+		}
+	}
+}
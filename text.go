@@ -0,0 +1,76 @@
+package money
+
+import (
+	"strings"
+)
+
+// String implements fmt.Stringer, it returns Money as Display does.
+func (m *Money) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+
+	return m.Display()
+}
+
+// MarshalText implements encoding.TextMarshaler. Money is encoded as its currency code
+// and amount in major units like "EUR 12.34", so it can be used in configuration files,
+// as a map key and as a flag value. Money held in other fraction digits than the ones
+// of its Currency in the registry, like Money returned by Rescale, is decoded in the
+// registered ones; use MarshalBinary to keep them. It returns ErrNilCurrency for Money
+// without Currency.
+func (m *Money) MarshalText() ([]byte, error) {
+	if m.Currency == nil {
+		return nil, ErrNilCurrency
+	}
+
+	c := m.Currency.get()
+
+	return []byte(canonicalCode(c.Code) + " " + formatDecimal(m.Amount, c.Fraction)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding Money encoded by
+// MarshalText. Malformed text is reported as ParseError and unknown codes as rejected
+// by the UnknownCodePolicy.
+func (m *Money) UnmarshalText(text []byte) error {
+	s := string(text)
+	code, amount, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok || code == "" {
+		return &ParseError{Input: s, Err: ErrInvalidAmount}
+	}
+
+	c, err := resolveCode(code)
+	if err != nil {
+		return err
+	}
+
+	a, err := parseDecimal(strings.TrimSpace(amount), c.Fraction)
+	if err != nil {
+		if perr, ok := err.(*ParseError); ok {
+			perr.Input = s
+		}
+		return err
+	}
+
+	*m = Money{Amount: a, Currency: c}
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the canonical encoding of
+// MarshalCanonical, so Money can be encoded with encoding/gob.
+func (m *Money) MarshalBinary() ([]byte, error) {
+	return m.MarshalCanonical()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding Money of any version
+// of the canonical encoding.
+func (m *Money) UnmarshalBinary(data []byte) error {
+	nm, _, err := UnmarshalCanonical(data)
+	if err != nil {
+		return err
+	}
+	*m = *nm
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package money
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"testing"
+)
+
+var (
+	_ fmt.Stringer               = (*Money)(nil)
+	_ encoding.TextMarshaler     = (*Money)(nil)
+	_ encoding.TextUnmarshaler   = (*Money)(nil)
+	_ encoding.BinaryMarshaler   = (*Money)(nil)
+	_ encoding.BinaryUnmarshaler = (*Money)(nil)
+)
+
+func TestMoney_String(t *testing.T) {
+	if r, expected := fmt.Sprint(New(123456, USD)), "$1,234.56"; r != expected {
+		t.Errorf("Expected %s got %s", expected, r)
+	}
+
+	if r, expected := (*Money)(nil).String(), "<nil>"; r != expected {
+		t.Errorf("Expected %s got %s", expected, r)
+	}
+}
+
+func TestMoney_MarshalText(t *testing.T) {
+	tcs := []struct {
+		m        *Money
+		expected string
+	}{
+		{New(1234, EUR), "EUR 12.34"},
+		{New(-5, USD), "USD -0.05"},
+		{New(500, JPY), "JPY 500"},
+		{New(1, KWD), "KWD 0.001"},
+		{New(-1, "xyz"), "XYZ -0.01"},
+	}
+
+	for _, tc := range tcs {
+		b, err := tc.m.MarshalText()
+		if err != nil || string(b) != tc.expected {
+			t.Errorf("Expected %s got %s (%v)", tc.expected, b, err)
+		}
+
+		var m Money
+		if err := m.UnmarshalText(b); err != nil || !m.Equal(tc.m) {
+			t.Errorf("Expected %v decoding %s got %v (%v)", tc.m, b, &m, err)
+		}
+	}
+
+	if _, err := (&Money{Amount: 1}).MarshalText(); !errors.Is(err, ErrNilCurrency) {
+		t.Errorf("Expected %v got %v", ErrNilCurrency, err)
+	}
+}
+
+func TestMoney_UnmarshalTextErrors(t *testing.T) {
+	tcs := []struct {
+		text string
+		err  error
+	}{
+		{"", ErrInvalidAmount},
+		{"12.34", ErrInvalidAmount},
+		{" 12.34", ErrInvalidAmount},
+		{"EUR 12,34", ErrInvalidAmount},
+		{"EUR 12.345", ErrPrecisionLoss},
+		{"EUR 999999999999999999999", ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		err := m.UnmarshalText([]byte(tc.text))
+
+		var perr *ParseError
+		if !errors.As(err, &perr) || perr.Input != tc.text || !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %q got %v", tc.err, tc.text, err)
+		}
+	}
+
+	defer SetUnknownCodePolicy(nil)
+	SetUnknownCodePolicy(UnknownCodeError())
+
+	var m Money
+	if err := m.UnmarshalText([]byte("XYZ 1")); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+}
+
+func TestMoney_TextFlag(t *testing.T) {
+	var m Money
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.TextVar(&m, "limit", New(1000, EUR), "spending limit")
+
+	if err := fs.Parse([]string{"-limit", "USD 25.50"}); err != nil || !m.Equal(New(2550, USD)) {
+		t.Errorf("Expected %v got %v (%v)", New(2550, USD), &m, err)
+	}
+}
+
+func TestMoney_MarshalJSONKeepsFields(t *testing.T) {
+	b, err := json.Marshal(struct{ Price *Money }{New(1234, EUR)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v struct {
+		Price struct {
+			Amount   int64
+			Currency struct{ Code string }
+		}
+	}
+	if err := json.Unmarshal(b, &v); err != nil || v.Price.Amount != 1234 || v.Price.Currency.Code != EUR {
+		t.Errorf("Expected Money encoded as its fields got %s (%v)", b, err)
+	}
+}
+
+func TestMoney_Gob(t *testing.T) {
+	type invoice struct {
+		Total *Money
+		Lines []*Money
+	}
+
+	in := invoice{Total: New(1500, EUR), Lines: []*Money{New(1000, EUR), New(500, EUR)}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+
+	var out invoice
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	if !out.Total.Equal(in.Total) || len(out.Lines) != 2 || !out.Lines[1].Equal(in.Lines[1]) {
+		t.Errorf("Expected %v got %v", in, out)
+	}
+
+	var m Money
+	if err := m.UnmarshalBinary([]byte{0xff}); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected %v got %v", ErrInvalidEncoding, err)
+	}
+}
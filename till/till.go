@@ -0,0 +1,255 @@
+// Package till models a cash drawer holding counts of banknotes and coins of a single
+// currency on top of money.Money.
+package till
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var (
+	// ErrUnknownDenomination happens when using a denomination the Till wasn't created with.
+	ErrUnknownDenomination = errors.New("till: unknown denomination")
+
+	// ErrInvalidCount happens when adding or removing a negative count.
+	ErrInvalidCount = errors.New("till: invalid count")
+
+	// ErrNoChange happens when the Till can't pay out an amount exactly.
+	ErrNoChange = errors.New("till: can't make change")
+)
+
+// maxChangeUnits bounds the table used to find the fewest pieces paying out change.
+// Larger amounts are paid out greedily.
+const maxChangeUnits = 1 << 16
+
+// Count is the number of pieces of a denomination.
+type Count struct {
+	Denomination *money.Money
+	Count        int
+}
+
+// Till holds counts per denomination of a single currency. Till is safe for concurrent use.
+type Till struct {
+	mu     sync.Mutex
+	zero   *money.Money
+	denoms []money.Amount // descending
+	counts map[money.Amount]int
+}
+
+// New creates new empty Till of given currency accepting given denominations in minor
+// units, e.g. 5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5, 2 and 1 for EUR.
+// Non-positive denominations are ignored.
+func New(code string, denominations ...money.Amount) *Till {
+	t := &Till{zero: money.New(0, code), counts: make(map[money.Amount]int)}
+	for _, d := range denominations {
+		if d > 0 && !slices.Contains(t.denoms, d) {
+			t.denoms = append(t.denoms, d)
+		}
+	}
+	slices.SortFunc(t.denoms, func(a, b money.Amount) int { return b.Cmp(a) })
+
+	return t
+}
+
+// NewFor creates new empty Till of given currency accepting the banknotes and coins of
+// money.GetDenominations. It returns ErrUnknownDenomination for currencies without
+// denominations.
+func NewFor(code string) (*Till, error) {
+	d, ok := money.GetDenominations(code)
+	if !ok {
+		return nil, fmt.Errorf("%w: no denominations of %s", ErrUnknownDenomination, code)
+	}
+
+	return New(code, d.All()...), nil
+}
+
+// Add adds n pieces of the denomination, e.g. when counting the float or taking cash.
+// It returns ErrUnknownDenomination and ErrInvalidCount.
+func (t *Till) Add(denomination money.Amount, n int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.check(denomination, n); err != nil {
+		return err
+	}
+	t.counts[denomination] += n
+
+	return nil
+}
+
+// Remove removes n pieces of the denomination. It returns money.ErrInsufficientFunds
+// when the Till holds less pieces, besides the errors of Add.
+func (t *Till) Remove(denomination money.Amount, n int) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.check(denomination, n); err != nil {
+		return err
+	}
+
+	if t.counts[denomination] < n {
+		return fmt.Errorf("%w: %d pieces of %s", money.ErrInsufficientFunds, t.counts[denomination], t.money(denomination).Display())
+	}
+	t.counts[denomination] -= n
+
+	return nil
+}
+
+// Counts returns the counts of all denominations, highest denomination first.
+func (t *Till) Counts() []Count {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cs := make([]Count, 0, len(t.denoms))
+	for _, d := range t.denoms {
+		cs = append(cs, Count{Denomination: t.money(d), Count: t.counts[d]})
+	}
+
+	return cs
+}
+
+// Total returns the Money held by the Till.
+func (t *Till) Total() *money.Money {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var total money.Amount
+	for d, n := range t.counts {
+		total += d * money.Amount(n)
+	}
+
+	return t.money(total)
+}
+
+// OverShort returns the Total less the expected balance, positive when the Till is over
+// and negative when it's short. It returns money.ErrCurrencyMismatch.
+func (t *Till) OverShort(expected *money.Money) (*money.Money, error) {
+	return t.Total().Subtract(expected)
+}
+
+// Change pays out Money with the fewest pieces the Till holds and returns the pieces
+// paid, highest denomination first. The Till is unchanged when it returns an error:
+// ErrNoChange when the pieces held can't make the amount exactly,
+// money.ErrCurrencyMismatch and money.ErrInvalidAmount for negative Money.
+func (t *Till) Change(m *money.Money) ([]Count, error) {
+	if _, err := t.zero.Compare(m); err != nil {
+		return nil, err
+	}
+
+	if m.IsNegative() {
+		return nil, money.ErrInvalidAmount
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	pay, ok := t.fewest(m.Amount)
+	if !ok {
+		pay, ok = t.greedy(m.Amount)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoChange, m.Display())
+	}
+
+	var cs []Count
+	for _, d := range t.denoms {
+		if n := pay[d]; n > 0 {
+			t.counts[d] -= n
+			cs = append(cs, Count{Denomination: t.money(d), Count: n})
+		}
+	}
+
+	return cs, nil
+}
+
+// fewest returns the fewest pieces making given amount, bounded by the counts held.
+// Counts are split into bundles of 1, 2, 4... pieces, each used at most once.
+// It reports false when no pieces make the amount or the amount is too large.
+func (t *Till) fewest(amount money.Amount) (map[money.Amount]int, bool) {
+	if amount > maxChangeUnits {
+		return nil, false
+	}
+	size := int(amount)
+
+	type bundle struct {
+		denom money.Amount
+		n     int
+	}
+
+	var bs []bundle
+	for _, d := range t.denoms {
+		left := t.counts[d]
+		for k := 1; left > 0; k *= 2 {
+			n := min(k, left)
+			if d*money.Amount(n) <= amount {
+				bs = append(bs, bundle{d, n})
+			}
+			left -= n
+		}
+	}
+
+	const inf = int(^uint(0) >> 1)
+	pieces := make([]int, size+1)
+	for v := 1; v <= size; v++ {
+		pieces[v] = inf
+	}
+
+	used := make([][]bool, len(bs))
+	for i, b := range bs {
+		used[i] = make([]bool, size+1)
+		w := int(b.denom) * b.n
+		for v := size; v >= w; v-- {
+			if pieces[v-w] != inf && pieces[v-w]+b.n < pieces[v] {
+				pieces[v] = pieces[v-w] + b.n
+				used[i][v] = true
+			}
+		}
+	}
+
+	if pieces[size] == inf {
+		return nil, false
+	}
+
+	pay := make(map[money.Amount]int)
+	for i, v := len(bs)-1, size; i >= 0; i-- {
+		if used[i][v] {
+			pay[bs[i].denom] += bs[i].n
+			v -= int(bs[i].denom) * bs[i].n
+		}
+	}
+
+	return pay, true
+}
+
+// greedy returns the pieces making given amount taking the highest denominations first.
+func (t *Till) greedy(amount money.Amount) (map[money.Amount]int, bool) {
+	pay := make(map[money.Amount]int)
+	for _, d := range t.denoms {
+		n := min(int(amount/d), t.counts[d])
+		pay[d] = n
+		amount -= d * money.Amount(n)
+	}
+
+	return pay, amount == 0
+}
+
+func (t *Till) check(denomination money.Amount, n int) error {
+	if !slices.Contains(t.denoms, denomination) {
+		return fmt.Errorf("%w: %s", ErrUnknownDenomination, t.money(denomination).Display())
+	}
+
+	if n < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidCount, n)
+	}
+
+	return nil
+}
+
+func (t *Till) money(a money.Amount) *money.Money {
+	return &money.Money{Amount: a, Currency: t.zero.Currency}
+}
@@ -0,0 +1,153 @@
+package till
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/seth-duckinga/go-money"
+)
+
+var euro = []money.Amount{5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5, 2, 1}
+
+func counts(cs []Count) map[money.Amount]int {
+	m := make(map[money.Amount]int)
+	for _, c := range cs {
+		if c.Count > 0 {
+			m[c.Denomination.Amount] = c.Count
+		}
+	}
+
+	return m
+}
+
+func equal(a, b map[money.Amount]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestTill(t *testing.T) {
+	tl := New(money.EUR, euro...)
+	for d, n := range map[money.Amount]int{2000: 2, 500: 3, 100: 4, 20: 10, 1: 7} {
+		if err := tl.Add(d, n); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if total := tl.Total(); total.Amount != 6107 {
+		t.Errorf("Expected total %d got %d", 6107, total.Amount)
+	}
+
+	cs := tl.Counts()
+	if len(cs) != len(euro) || cs[0].Denomination.Amount != 5000 || cs[1].Count != 2 {
+		t.Errorf("Expected counts of all denominations highest first got %v", cs)
+	}
+
+	if os, _ := tl.OverShort(money.New(6000, money.EUR)); os.Amount != 107 {
+		t.Errorf("Expected over by %d got %d", 107, os.Amount)
+	}
+
+	if os, _ := tl.OverShort(money.New(6200, money.EUR)); os.Amount != -93 {
+		t.Errorf("Expected short by %d got %d", -93, os.Amount)
+	}
+
+	if err := tl.Remove(2000, 3); !errors.Is(err, money.ErrInsufficientFunds) {
+		t.Errorf("Expected %v got %v", money.ErrInsufficientFunds, err)
+	}
+
+	if err := tl.Add(3, 1); !errors.Is(err, ErrUnknownDenomination) {
+		t.Errorf("Expected %v got %v", ErrUnknownDenomination, err)
+	}
+
+	if err := tl.Add(1, -1); !errors.Is(err, ErrInvalidCount) {
+		t.Errorf("Expected %v got %v", ErrInvalidCount, err)
+	}
+
+	if err := tl.Remove(2000, 1); err != nil || tl.Total().Amount != 4107 {
+		t.Errorf("Expected total %d got %v (%v)", 4107, tl.Total(), err)
+	}
+}
+
+func TestTill_Change(t *testing.T) {
+	tcs := []struct {
+		denoms   []money.Amount
+		held     map[money.Amount]int
+		change   money.Amount
+		expected map[money.Amount]int
+		err      error
+	}{
+		{euro, map[money.Amount]int{500: 2, 200: 5, 100: 5, 20: 5, 1: 10}, 743, map[money.Amount]int{500: 1, 200: 1, 20: 2, 1: 3}, nil},
+		// Greedy takes the 50 and gets stuck.
+		{[]money.Amount{50, 20, 10}, map[money.Amount]int{50: 1, 20: 3}, 60, map[money.Amount]int{20: 3}, nil},
+		// Greedy pays 4+1+1.
+		{[]money.Amount{4, 3, 1}, map[money.Amount]int{4: 5, 3: 5, 1: 5}, 6, map[money.Amount]int{3: 2}, nil},
+		{euro, map[money.Amount]int{500: 1}, 0, map[money.Amount]int{}, nil},
+		{euro, map[money.Amount]int{500: 1, 200: 1}, 300, nil, ErrNoChange},
+		{euro, map[money.Amount]int{5000: 2000}, 100000, map[money.Amount]int{5000: 20}, nil},
+	}
+
+	for _, tc := range tcs {
+		tl := New(money.EUR, tc.denoms...)
+		for d, n := range tc.held {
+			_ = tl.Add(d, n)
+		}
+		before := tl.Total()
+
+		cs, err := tl.Change(money.New(int64(tc.change), money.EUR))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v got %v", tc.err, err)
+			continue
+		}
+
+		if err != nil {
+			if !tl.Total().Equal(before) {
+				t.Errorf("Expected unchanged till got %v", tl.Total())
+			}
+			continue
+		}
+
+		if !equal(counts(cs), tc.expected) {
+			t.Errorf("Expected change of %d to be %v got %v", tc.change, tc.expected, counts(cs))
+		}
+
+		if tl.Total().Amount != before.Amount-tc.change {
+			t.Errorf("Expected total %d got %d", before.Amount-tc.change, tl.Total().Amount)
+		}
+	}
+
+	tl := New(money.EUR, euro...)
+	if _, err := tl.Change(money.New(1, money.USD)); !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", money.ErrCurrencyMismatch, err)
+	}
+
+	if _, err := tl.Change(money.New(-1, money.EUR)); !errors.Is(err, money.ErrInvalidAmount) {
+		t.Errorf("Expected %v got %v", money.ErrInvalidAmount, err)
+	}
+}
+
+func TestNewFor(t *testing.T) {
+	tl, err := NewFor(money.CHF)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cs := tl.Counts(); len(cs) != 13 || cs[len(cs)-1].Denomination.Amount != 5 {
+		t.Errorf("Expected CHF denominations down to %d got %v", 5, cs)
+	}
+
+	if err := tl.Add(1, 1); !errors.Is(err, ErrUnknownDenomination) {
+		t.Errorf("Expected %v got %v", ErrUnknownDenomination, err)
+	}
+
+	if _, err := NewFor("XTS"); !errors.Is(err, ErrUnknownDenomination) {
+		t.Errorf("Expected %v got %v", ErrUnknownDenomination, err)
+	}
+}
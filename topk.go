@@ -0,0 +1,120 @@
+package money
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"slices"
+)
+
+// Ranked is a Money selected by TopKIndexed or BottomKIndexed along with its index
+// in the original slice.
+type Ranked struct {
+	Index int
+	Money *Money
+}
+
+// TopK returns the k Money with the largest values from given slice, from the largest
+// to the smallest. When several Money share the same value the ones listed first win
+// and are returned in the order they are listed in.
+// It runs in O(n log k) without sorting the whole slice. Nil values are skipped,
+// ErrNoValues is returned when there is nothing to select and ErrCurrencyMismatch
+// when the Money don't share the same Currency.
+func TopK(ms []*Money, k int) ([]*Money, error) {
+	return unranked(selectK(ms, k, 1))
+}
+
+// BottomK returns the k Money with the smallest values from given slice, from the smallest
+// to the largest. It behaves like TopK otherwise.
+func BottomK(ms []*Money, k int) ([]*Money, error) {
+	return unranked(selectK(ms, k, -1))
+}
+
+// TopKIndexed is like TopK but also returns the index of each selected Money.
+func TopKIndexed(ms []*Money, k int) ([]Ranked, error) {
+	return selectK(ms, k, 1)
+}
+
+// BottomKIndexed is like BottomK but also returns the index of each selected Money.
+func BottomKIndexed(ms []*Money, k int) ([]Ranked, error) {
+	return selectK(ms, k, -1)
+}
+
+func selectK(ms []*Money, k, dir int) ([]Ranked, error) {
+	if k <= 0 {
+		return nil, fmt.Errorf("%w: k must be higher than zero", ErrInvalidRatio)
+	}
+
+	if _, err := firstOfCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	h := &rankedHeap{dir: dir}
+	for i, m := range ms {
+		if m == nil {
+			continue
+		}
+
+		r := Ranked{Index: i, Money: m}
+		switch {
+		case h.Len() < k:
+			heap.Push(h, r)
+		case h.worse(h.items[0], r):
+			h.items[0] = r
+			heap.Fix(h, 0)
+		}
+	}
+
+	// Order from the best to the worst, equal values in the order of the given slice.
+	rs := h.items
+	slices.SortFunc(rs, func(a, b Ranked) int {
+		if c := b.Money.compare(a.Money) * dir; c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Index, b.Index)
+	})
+
+	return rs, nil
+}
+
+func unranked(rs []Ranked, err error) ([]*Money, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	ms := make([]*Money, len(rs))
+	for i, r := range rs {
+		ms[i] = r.Money
+	}
+
+	return ms, nil
+}
+
+// rankedHeap keeps the worst selected Money at its root, so it can be replaced
+// as soon as a better one is found.
+type rankedHeap struct {
+	items []Ranked
+	dir   int
+}
+
+// worse reports whether a ranks below b: its value is further from the selected
+// extreme or, for equal values, it comes later in the original slice.
+func (h *rankedHeap) worse(a, b Ranked) bool {
+	if c := a.Money.compare(b.Money) * h.dir; c != 0 {
+		return c < 0
+	}
+
+	return a.Index > b.Index
+}
+
+func (h *rankedHeap) Len() int           { return len(h.items) }
+func (h *rankedHeap) Less(i, j int) bool { return h.worse(h.items[i], h.items[j]) }
+func (h *rankedHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *rankedHeap) Push(x any)         { h.items = append(h.items, x.(Ranked)) }
+
+func (h *rankedHeap) Pop() any {
+	r := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return r
+}
@@ -0,0 +1,84 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		k        int
+		expected []int64
+	}{
+		{[]int64{5, 1, 9, 3, 7}, 3, []int64{9, 7, 5}},
+		{[]int64{5, 1, 9}, 5, []int64{9, 5, 1}},
+		{[]int64{-5, -1, -9}, 1, []int64{-1}},
+		{[]int64{2, 2, 2, 1}, 2, []int64{2, 2}},
+	}
+
+	for _, tc := range tcs {
+		r, err := TopK(moneys(EUR, tc.amounts...), tc.k)
+		if err != nil || !reflect.DeepEqual(amounts(r), tc.expected) {
+			t.Errorf("Expected %v got %v (%v)", tc.expected, amounts(r), err)
+		}
+	}
+}
+
+func TestBottomK(t *testing.T) {
+	ms := moneys(EUR, 5, 1, 9, 3, 7)
+	ms = append(ms, nil)
+
+	r, err := BottomK(ms, 2)
+	if expected := []int64{1, 3}; err != nil || !reflect.DeepEqual(amounts(r), expected) {
+		t.Errorf("Expected %v got %v (%v)", expected, amounts(r), err)
+	}
+}
+
+func TestTopKIndexed(t *testing.T) {
+	r, err := TopKIndexed(moneys(EUR, 4, 8, 1, 8, 4), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int{1, 3, 0}
+	for i, idx := range expected {
+		if r[i].Index != idx {
+			t.Errorf("Expected index %d at %d got %d", idx, i, r[i].Index)
+		}
+	}
+
+	r, _ = TopKIndexed(moneys(EUR, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 9), 6)
+	if idxs := indexes(r); !reflect.DeepEqual(idxs, []int{10, 0, 1, 2, 3, 4}) {
+		t.Errorf("Expected equal values in the order of the slice %v got %v", []int{10, 0, 1, 2, 3, 4}, idxs)
+	}
+
+	b, _ := BottomKIndexed(moneys(EUR, 4, 8, 1, 8, 4), 2)
+	if b[0].Index != 2 || b[1].Index != 0 {
+		t.Errorf("Expected indexes %v got %v", []int{2, 0}, b)
+	}
+}
+
+func TestTopK_Errors(t *testing.T) {
+	if _, err := TopK(moneys(EUR, 1), 0); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := TopK(nil, 1); !errors.Is(err, ErrNoValues) {
+		t.Errorf("Expected %v got %v", ErrNoValues, err)
+	}
+
+	if _, err := BottomK([]*Money{New(1, EUR), New(1, USD)}, 1); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func indexes(rs []Ranked) []int {
+	idxs := make([]int, len(rs))
+	for i, r := range rs {
+		idxs[i] = r.Index
+	}
+
+	return idxs
+}
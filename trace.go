@@ -0,0 +1,178 @@
+package money
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Step records one operation applied to Traced Money.
+type Step struct {
+	// Operation is the name of the Money method, e.g. "add" or "multiply_rat".
+	Operation string `json:"operation"`
+	// Input is the value the operation was applied to.
+	Input Value `json:"input"`
+	// Operand is the other Money of operations like Add and Subtract.
+	Operand *Value `json:"operand,omitempty"`
+	// Factor is the multiplier or the ratios of the operation, e.g. "3", "19/100" or "1:2".
+	Factor string `json:"factor,omitempty"`
+	// Rounding is the RoundingMode applied, for operations which round.
+	Rounding string `json:"rounding,omitempty"`
+	// Result is the value the operation produced.
+	Result Value `json:"result"`
+	// Parts are the values of the parties of Allocate and Split.
+	Parts []Value `json:"parts,omitempty"`
+}
+
+// Traced wraps Money and records every operation applied to it as a Step, so the way a
+// final amount was derived can be shown, e.g. in audit logs. Operations work like the
+// Money methods of the same name. A failed operation is not recorded and leaves the
+// Money as is. Allocate and Split record their parties, but keep the Money whole.
+// Traced is encoded to JSON as its result and steps. It is not safe for concurrent use.
+type Traced struct {
+	m     *Money
+	steps []Step
+}
+
+// Trace starts tracing operations on given Money. It returns ErrNilMoney for nil Money
+// and Money without Currency.
+func Trace(m *Money) (*Traced, error) {
+	if !m.IsValid() {
+		return nil, ErrNilMoney
+	}
+
+	return &Traced{m: m}, nil
+}
+
+// Money returns the current Money.
+func (t *Traced) Money() *Money {
+	return t.m
+}
+
+// Steps returns a copy of the steps recorded so far, in the order they were applied.
+func (t *Traced) Steps() []Step {
+	steps := make([]Step, len(t.steps))
+	copy(steps, t.steps)
+
+	return steps
+}
+
+// Add adds other Money, see Money.Add.
+func (t *Traced) Add(om *Money) error {
+	r, err := t.m.Add(om)
+	if err != nil {
+		return err
+	}
+
+	v := om.ToValue()
+	t.record(Step{Operation: "add", Operand: &v}, r)
+
+	return nil
+}
+
+// Subtract subtracts other Money, see Money.Subtract.
+func (t *Traced) Subtract(om *Money) error {
+	r, err := t.m.Subtract(om)
+	if err != nil {
+		return err
+	}
+
+	v := om.ToValue()
+	t.record(Step{Operation: "subtract", Operand: &v}, r)
+
+	return nil
+}
+
+// Multiply multiplies by given multiplier, see Money.MultiplyChecked. It returns
+// ErrOverflow when the result doesn't fit into Amount.
+func (t *Traced) Multiply(mul int64) error {
+	m, err := t.m.MultiplyChecked(mul)
+	if err != nil {
+		return err
+	}
+
+	t.record(Step{Operation: "multiply", Factor: strconv.FormatInt(mul, 10)}, m)
+
+	return nil
+}
+
+// MultiplyRat multiplies by r rounded with given mode, see Money.MultiplyRat.
+func (t *Traced) MultiplyRat(r *big.Rat, mode RoundingMode) error {
+	m, err := t.m.MultiplyRat(r, mode)
+	if err != nil {
+		return err
+	}
+
+	t.record(Step{Operation: "multiply_rat", Factor: r.RatString(), Rounding: mode.String()}, m)
+
+	return nil
+}
+
+// RoundWithMode rounds to whole major units with given mode, see Money.RoundWithMode.
+func (t *Traced) RoundWithMode(mode RoundingMode) error {
+	m, err := t.m.RoundWithMode(mode)
+	if err != nil {
+		return err
+	}
+
+	t.record(Step{Operation: "round", Rounding: mode.String()}, m)
+
+	return nil
+}
+
+// Split returns the parties of splitting the Money into n, see Money.Split.
+func (t *Traced) Split(n int) ([]*Money, error) {
+	ms, err := t.m.Split(n)
+	if err != nil {
+		return nil, err
+	}
+
+	t.record(Step{Operation: "split", Factor: strconv.Itoa(n), Parts: values(ms)}, t.m)
+
+	return ms, nil
+}
+
+// Allocate returns the parties of allocating the Money by given ratios, see
+// Money.Allocate.
+func (t *Traced) Allocate(rs ...int) ([]*Money, error) {
+	ms, err := t.m.Allocate(rs...)
+	if err != nil {
+		return nil, err
+	}
+
+	factor := make([]string, len(rs))
+	for i, r := range rs {
+		factor[i] = strconv.Itoa(r)
+	}
+	t.record(Step{Operation: "allocate", Factor: strings.Join(factor, ":"), Parts: values(ms)}, t.m)
+
+	return ms, nil
+}
+
+// record completes given step with the current and the resulting Money and makes the
+// result current.
+func (t *Traced) record(s Step, result *Money) {
+	s.Input = t.m.ToValue()
+	s.Result = result.ToValue()
+	t.steps = append(t.steps, s)
+	t.m = result
+}
+
+// MarshalJSON implements json.Marshaler, encoding Traced like
+// {"result":{"amount":1200,"currency":"EUR"},"steps":[...]}.
+func (t *Traced) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Result Value  `json:"result"`
+		Steps  []Step `json:"steps"`
+	}{t.m.ToValue(), t.Steps()})
+}
+
+func values(ms []*Money) []Value {
+	vs := make([]Value, len(ms))
+	for i, m := range ms {
+		vs[i] = m.ToValue()
+	}
+
+	return vs
+}
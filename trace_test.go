@@ -0,0 +1,95 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestTraced(t *testing.T) {
+	tr, err := Trace(New(1000, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tr.Add(New(250, EUR)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Subtract(New(50, EUR)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Multiply(3); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.MultiplyRat(big.NewRat(19, 100), RoundHalfEven); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.RoundWithMode(RoundUp); err != nil {
+		t.Fatal(err)
+	}
+	ms, err := tr.Allocate(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tr.Split(3); err != nil {
+		t.Fatal(err)
+	}
+
+	eur := func(a int64) Value { return NewValue(a, EUR) }
+	operand := func(a int64) *Value { v := eur(a); return &v }
+
+	expected := []Step{
+		{Operation: "add", Input: eur(1000), Operand: operand(250), Result: eur(1250)},
+		{Operation: "subtract", Input: eur(1250), Operand: operand(50), Result: eur(1200)},
+		{Operation: "multiply", Input: eur(1200), Factor: "3", Result: eur(3600)},
+		{Operation: "multiply_rat", Input: eur(3600), Factor: "19/100", Rounding: "half_even", Result: eur(684)},
+		{Operation: "round", Input: eur(684), Rounding: "up", Result: eur(700)},
+		{Operation: "allocate", Input: eur(700), Factor: "1:2", Result: eur(700), Parts: []Value{eur(234), eur(466)}},
+		{Operation: "split", Input: eur(700), Factor: "3", Result: eur(700), Parts: []Value{eur(234), eur(233), eur(233)}},
+	}
+
+	if steps := tr.Steps(); !reflect.DeepEqual(steps, expected) {
+		t.Errorf("Expected steps %+v got %+v", expected, steps)
+	}
+
+	if tr.Money().Amount != 700 || ms[0].Amount != 234 {
+		t.Errorf("Expected %d and first party %d got %v and %v", 700, 234, tr.Money(), ms[0])
+	}
+}
+
+func TestTraced_Errors(t *testing.T) {
+	if _, err := Trace(&Money{Amount: 1}); !errors.Is(err, ErrNilMoney) {
+		t.Errorf("Expected %v got %v", ErrNilMoney, err)
+	}
+
+	tr, _ := Trace(New(int64(MaxAmount), EUR))
+	if err := tr.Add(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+	if err := tr.Add(New(1, EUR)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+	if err := tr.Multiply(2); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+	if _, err := tr.Allocate(); !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Expected %v got %v", ErrInvalidRatio, err)
+	}
+
+	if len(tr.Steps()) != 0 || tr.Money().Amount != MaxAmount {
+		t.Errorf("Expected failed operations not to be recorded got %v", tr.Steps())
+	}
+}
+
+func TestTraced_MarshalJSON(t *testing.T) {
+	tr, _ := Trace(New(1000, EUR))
+	_ = tr.Add(New(-1, EUR))
+
+	b, err := json.Marshal(tr)
+	expected := `{"result":{"amount":999,"currency":"EUR"},"steps":[{"operation":"add","input":{"amount":1000,"currency":"EUR"},"operand":{"amount":-1,"currency":"EUR"},"result":{"amount":999,"currency":"EUR"}}]}`
+	if err != nil || string(b) != expected {
+		t.Errorf("Expected %s got %s (%v)", expected, b, err)
+	}
+}
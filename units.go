@@ -0,0 +1,95 @@
+package money
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// FromMajorUnits creates and returns new instance of Money from a plain decimal string in
+// major units like "10.99" or "-0.5". Unlike NewFromFloat it never rounds: extra fraction
+// digits are accepted only when they are zeros. Options customize the Currency like with
+// NewWithOptions. It returns the errors of NewWithOptions for the code and ParseError
+// wrapping ErrInvalidAmount, ErrPrecisionLoss or ErrOverflow for the amount. See
+// moneydecimal.FromDecimal for decimal values.
+func FromMajorUnits(s, code string, opts ...Option) (*Money, error) {
+	m, err := NewWithOptions(0, code, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Amount, err = parseDecimal(s, m.Currency.get().Fraction); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// NewFromMajorMinor creates and returns new instance of Money from its major and minor
+// parts, e.g. 12 and 50 for $12.50. The minor part must be below one major unit of the
+// Currency, so it is always zero for currencies without fraction digits like JPY, and
+// negative amounts have both parts negative or zero, e.g. -12 and -50 for -$12.50.
+// Options customize the Currency like with NewWithOptions. It returns the errors of
+// NewWithOptions for the code, ErrInvalidAmount for invalid parts and ErrOverflow when
+// the amount doesn't fit into Amount.
+func NewFromMajorMinor(major, minor int64, code string, opts ...Option) (*Money, error) {
+	m, err := NewWithOptions(0, code, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	f := m.Currency.get().Fraction
+	unit, ok := pow10(f)
+	if !ok {
+		return nil, fmt.Errorf("%w: fraction %d", ErrOverflow, f)
+	}
+
+	switch {
+	case minor <= -unit || minor >= unit:
+		return nil, fmt.Errorf("%w: minor part %d of %s with %d fraction digits", ErrInvalidAmount, minor, m.Currency.Code, f)
+	case (major < 0 && minor > 0) || (major > 0 && minor < 0):
+		return nil, fmt.Errorf("%w: parts %d and %d of different signs", ErrInvalidAmount, major, minor)
+	}
+
+	a, ok := mutate.calc.multiplyChecked(Amount(major), unit)
+	if ok {
+		a, ok = mutate.calc.addChecked(a, Amount(minor))
+	}
+	if !ok {
+		return nil, ErrOverflow
+	}
+	m.Amount = a
+
+	return m, nil
+}
+
+// MajorUnitsString returns the amount of Money in major units as a plain decimal string
+// like "-1234.56", without grouping or currency symbols. It is exact and round-trips with
+// FromMajorUnits.
+func (m *Money) MajorUnitsString() string {
+	return formatDecimal(m.Amount, m.Currency.get().Fraction)
+}
+
+// MinorUnits returns the amount of Money in minor units, e.g. 1099 for $10.99.
+func (m *Money) MinorUnits() int64 {
+	return int64(m.Amount)
+}
+
+// AsMajorUnitsExact works like AsMajorUnits, but returns ErrPrecisionLoss when float64
+// can't hold the amount, i.e. when the float doesn't convert back into the same amount
+// of minor units. The float is the nearest one to the exact amount, so e.g. 0.1 passes
+// while amounts with more significant digits than float64 keeps fail.
+func (m *Money) AsMajorUnitsExact() (float64, error) {
+	s := m.MajorUnitsString()
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	a, err := parseDecimal(strconv.FormatFloat(f, 'f', -1, 64), m.Currency.get().Fraction)
+	if err != nil || a != m.Amount {
+		return 0, ErrPrecisionLoss
+	}
+
+	return f, nil
+}
@@ -0,0 +1,165 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFromMajorUnits(t *testing.T) {
+	tcs := []struct {
+		input    string
+		code     string
+		expected Amount
+	}{
+		{"10.99", USD, 1099},
+		{"-0.5", USD, -50},
+		{"+3", USD, 300},
+		{"10.990", USD, 1099},
+		{"1234", JPY, 1234},
+		{"1.234", KWD, 1234},
+		{"92233720368547758.07", USD, MaxAmount},
+		{"-92233720368547758.08", USD, MinAmount},
+	}
+
+	for _, tc := range tcs {
+		m, err := FromMajorUnits(tc.input, tc.code)
+		if err != nil || m.Amount != tc.expected || m.Currency.Code != tc.code {
+			t.Errorf("Expected %q to be %d %s got %v (%v)", tc.input, tc.expected, tc.code, m, err)
+		}
+	}
+}
+
+func TestFromMajorUnits_Errors(t *testing.T) {
+	tcs := []struct {
+		input string
+		err   error
+	}{
+		{"", ErrInvalidAmount},
+		{"1,000.00", ErrInvalidAmount},
+		{"$10", ErrInvalidAmount},
+		{"10.999", ErrPrecisionLoss},
+		{"92233720368547758.08", ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		if _, err := FromMajorUnits(tc.input, USD); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %q got %v", tc.err, tc.input, err)
+		}
+	}
+
+	if _, err := FromMajorUnits("1", "XYZ", WithStrictCode()); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+}
+
+func TestMoney_MajorUnitsString(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{1099, USD, "10.99"},
+		{-5, USD, "-0.05"},
+		{0, USD, "0.00"},
+		{1234, JPY, "1234"},
+		{-1234567, KWD, "-1234.567"},
+		{int64(MinAmount), USD, "-92233720368547758.08"},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, tc.code)
+		if s := m.MajorUnitsString(); s != tc.expected {
+			t.Errorf("Expected %q got %q", tc.expected, s)
+		}
+
+		if r, err := FromMajorUnits(m.MajorUnitsString(), tc.code); err != nil || r.Amount != m.Amount {
+			t.Errorf("Expected %q to round-trip to %d got %v (%v)", tc.expected, tc.amount, r, err)
+		}
+
+		if m.MinorUnits() != tc.amount {
+			t.Errorf("Expected minor units %d got %d", tc.amount, m.MinorUnits())
+		}
+	}
+}
+
+func TestMoney_AsMajorUnitsExact(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected float64
+	}{
+		{1099, USD, 10.99},
+		{10, USD, 0.1},
+		{-1, USD, -0.01},
+		{1234, JPY, 1234},
+		{1 << 53, JPY, 1 << 53},
+		{999999999999999, USD, 9999999999999.99},
+	}
+
+	for _, tc := range tcs {
+		if f, err := New(tc.amount, tc.code).AsMajorUnitsExact(); err != nil || f != tc.expected {
+			t.Errorf("Expected %d %s to be %v got %v (%v)", tc.amount, tc.code, tc.expected, f, err)
+		}
+	}
+
+	for _, m := range []*Money{New(1<<53+1, JPY), New(int64(MaxAmount), USD), New(1234567890123456789, KWD)} {
+		if _, err := m.AsMajorUnitsExact(); !errors.Is(err, ErrPrecisionLoss) {
+			t.Errorf("Expected %v for %d got %v", ErrPrecisionLoss, m.Amount, err)
+		}
+	}
+}
+
+func TestNewFromMajorMinor(t *testing.T) {
+	tcs := []struct {
+		major, minor int64
+		code         string
+		expected     Amount
+	}{
+		{12, 50, USD, 1250},
+		{12, 5, USD, 1205},
+		{-12, -50, USD, -1250},
+		{0, -50, USD, -50},
+		{-12, 0, USD, -1200},
+		{1234, 0, JPY, 1234},
+		{1, 999, KWD, 1999},
+		{92233720368547758, 7, USD, MaxAmount},
+		{-92233720368547758, -8, USD, MinAmount},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromMajorMinor(tc.major, tc.minor, tc.code)
+		if err != nil || m.Amount != tc.expected || m.Currency.Code != tc.code {
+			t.Errorf("Expected %d and %d to be %d %s got %v (%v)", tc.major, tc.minor, tc.expected, tc.code, m, err)
+		}
+	}
+}
+
+func TestNewFromMajorMinor_Errors(t *testing.T) {
+	tcs := []struct {
+		major, minor int64
+		code         string
+		err          error
+	}{
+		{12, 100, USD, ErrInvalidAmount},
+		{12, -100, USD, ErrInvalidAmount},
+		{1, 1, JPY, ErrInvalidAmount},
+		{-12, 50, USD, ErrInvalidAmount},
+		{12, -50, USD, ErrInvalidAmount},
+		{92233720368547758, 8, USD, ErrOverflow},
+		{92233720368547759, 0, USD, ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		if _, err := NewFromMajorMinor(tc.major, tc.minor, tc.code); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %d and %d %s got %v", tc.err, tc.major, tc.minor, tc.code, err)
+		}
+	}
+
+	if m, err := NewFromMajorMinor(1, 5, USD, WithFraction(1)); err != nil || m.Amount != 15 {
+		t.Errorf("Expected %d got %v (%v)", 15, m, err)
+	}
+
+	if _, err := NewFromMajorMinor(1, 0, "XYZ", WithStrictCode()); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+}
@@ -0,0 +1,39 @@
+package money
+
+// nanosPerUnit is the number of nanos in a major unit, as used by google.type.Money.
+const nanosPerUnit = 1000000000
+
+// NewFromUnitsNanos creates and returns new instance of Money from whole units and nano
+// (10^-9) units of the amount, as in google.type.Money. Units and nanos must have the same
+// sign and nanos must lie between -999,999,999 and 999,999,999, otherwise ErrInvalidAmount
+// is returned. It returns ErrPrecisionLoss when nanos hold more decimals than the Currency
+// and ErrOverflow when the amount doesn't fit into Amount.
+func NewFromUnitsNanos(units int64, nanos int32, code string) (*Money, error) {
+	if nanos <= -nanosPerUnit || nanos >= nanosPerUnit || (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return nil, ErrInvalidAmount
+	}
+
+	m, err := NewWithOptions(0, code)
+	if err != nil {
+		return nil, err
+	}
+	f := m.Currency.Fraction
+
+	u, err := rescaleAmount(Amount(units), 0, f)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := rescaleAmount(Amount(nanos), 9, f)
+	if err != nil {
+		return nil, err
+	}
+
+	a, ok := mutate.calc.addChecked(u, n)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	m.Amount = a
+
+	return m, nil
+}
@@ -0,0 +1,41 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewFromUnitsNanos(t *testing.T) {
+	tcs := []struct {
+		units    int64
+		nanos    int32
+		code     string
+		expected Amount
+		err      error
+	}{
+		{12, 340000000, EUR, 1234, nil},
+		{-12, -340000000, EUR, -1234, nil},
+		{0, -500000000, EUR, -50, nil},
+		{1, 5000000, KWD, 1005, nil},
+		{1500, 0, JPY, 1500, nil},
+		{1, 5000000, EUR, 0, ErrPrecisionLoss},
+		{1, -340000000, EUR, 0, ErrInvalidAmount},
+		{-1, 340000000, EUR, 0, ErrInvalidAmount},
+		{0, 1000000000, EUR, 0, ErrInvalidAmount},
+		{math.MaxInt64, 0, EUR, 0, ErrOverflow},
+		{math.MaxInt64 / 100, 990000000, EUR, 0, ErrOverflow},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromUnitsNanos(tc.units, tc.nanos, tc.code)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for %d %d got %v", tc.err, tc.units, tc.nanos, err)
+			continue
+		}
+
+		if err == nil && (m.Amount != tc.expected || m.Currency.Code != tc.code) {
+			t.Errorf("Expected %d %s got %d %s", tc.expected, tc.code, m.Amount, m.Currency.Code)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package money
+
+import "sync/atomic"
+
+// UnknownCodePolicy resolves currency codes not found in the registry when Money is
+// created. It's given the trimmed and upper-cased code and returns the Currency to use
+// or an error.
+type UnknownCodePolicy func(code string) (*Currency, error)
+
+var unknownCodePolicy atomic.Pointer[UnknownCodePolicy]
+
+// SetUnknownCodePolicy sets the UnknownCodePolicy used by New, NewWithOptions and Pool.New.
+// Nil restores the default, UnknownCodeFallback(2). New and Pool.New panic when the policy
+// returns an error, use NewWithOptions to get the error instead.
+func SetUnknownCodePolicy(p UnknownCodePolicy) {
+	if p == nil {
+		unknownCodePolicy.Store(nil)
+		return
+	}
+
+	unknownCodePolicy.Store(&p)
+}
+
+// UnknownCodeError rejects unknown codes with UnsupportedCurrencyError.
+func UnknownCodeError() UnknownCodePolicy {
+	return func(code string) (*Currency, error) {
+		return nil, &UnsupportedCurrencyError{Code: code}
+	}
+}
+
+// UnknownCodeFallback falls back to a generic Currency with given fraction digits,
+// displayed with the code as its grapheme. The fallback isn't registered.
+func UnknownCodeFallback(fraction int) UnknownCodePolicy {
+	return func(code string) (*Currency, error) {
+		c := newCurrency(code).getDefault()
+		c.Fraction = fraction
+		return c, nil
+	}
+}
+
+// UnknownCodeFetch calls fetch for unknown codes and registers the Currency it returns,
+// so fetch is called once per code, e.g. to load currencies from a database on demand.
+// Errors of fetch are returned as is, a nil Currency as UnsupportedCurrencyError.
+func UnknownCodeFetch(fetch func(code string) (*Currency, error)) UnknownCodePolicy {
+	return func(code string) (*Currency, error) {
+		c, err := fetch(code)
+		if err != nil {
+			return nil, err
+		}
+
+		if c == nil {
+			return nil, &UnsupportedCurrencyError{Code: code}
+		}
+
+		updateRegistry(func(cs Currencies) Currencies { return cs.Add(c) })
+		return c, nil
+	}
+}
+
+// resolveCode returns the registered Currency of given code or resolves it with the
+// active UnknownCodePolicy.
+func resolveCode(code string) (*Currency, error) {
+	if c := GetCurrency(code); c != nil {
+		return c, nil
+	}
+
+	return unknownCode(code)
+}
+
+func unknownCode(code string) (*Currency, error) {
+	code = canonicalCode(code)
+	if p := unknownCodePolicy.Load(); p != nil {
+		c, err := (*p)(code)
+		return c.clone(), err
+	}
+
+	return newCurrency(code).getDefault(), nil
+}
@@ -0,0 +1,112 @@
+package money
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+func TestUnknownCodePolicy(t *testing.T) {
+	defer SetUnknownCodePolicy(nil)
+
+	if m := New(1, "unk"); m.Currency.Code != "UNK" || m.Currency.Fraction != 2 {
+		t.Errorf("Expected default fallback for %s got %+v", "UNK", m.Currency)
+	}
+
+	SetUnknownCodePolicy(UnknownCodeFallback(8))
+	if m := New(1, "unk"); m.Currency.Fraction != 8 || m.Display() != "0.00000001UNK" {
+		t.Errorf("Expected fallback with %d fraction digits got %+v", 8, m.Currency)
+	}
+
+	if m := New(1, EUR); *m.Currency != *GetCurrency(EUR) {
+		t.Errorf("Expected registered %s got %+v", EUR, m.Currency)
+	}
+
+	SetUnknownCodePolicy(UnknownCodeError())
+	if _, err := NewWithOptions(1, "UNK"); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+
+	defer func() {
+		if r := recover(); !errors.Is(r.(error), ErrUnsupportedCurrency) {
+			t.Errorf("Expected panic with %v got %v", ErrUnsupportedCurrency, r)
+		}
+	}()
+	New(1, "UNK")
+}
+
+func TestUnknownCodeFetch(t *testing.T) {
+	defer SetUnknownCodePolicy(nil)
+
+	calls := 0
+	SetUnknownCodePolicy(UnknownCodeFetch(func(code string) (*Currency, error) {
+		calls++
+		if code != "FETCHED" {
+			return nil, nil
+		}
+
+		return &Currency{Code: code, Fraction: 3, Decimal: ".", Grapheme: "F", Template: "$1"}, nil
+	}))
+
+	for i := 0; i < 2; i++ {
+		if m := New(1, "fetched"); m.Display() != "F0.001" {
+			t.Errorf("Expected %s got %s", "F0.001", m.Display())
+		}
+	}
+
+	if calls != 1 || GetCurrency("FETCHED") == nil {
+		t.Errorf("Expected fetched currency to be registered once got %d calls", calls)
+	}
+
+	if _, err := NewWithOptions(1, "MISSING"); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+	}
+}
+
+func TestUnknownCodeErrorConstructors(t *testing.T) {
+	SetUnknownCodePolicy(UnknownCodeError())
+	defer SetUnknownCodePolicy(nil)
+
+	rp := StaticRates{}
+	tcs := []struct {
+		name string
+		fn   func() error
+	}{
+		{"NewFromFloatChecked", func() error { _, err := NewFromFloatChecked(1.5, "UNK", RoundHalfEven); return err }},
+		{"NewFromScale", func() error { _, err := NewFromScale(150, 2, "UNK"); return err }},
+		{"NewFromUnitsNanos", func() error { _, err := NewFromUnitsNanos(1, 0, "UNK"); return err }},
+		{"FromKey", func() error { _, err := FromKey("UNK:100"); return err }},
+		{"NewContext", func() error { _, err := NewContext(context.Background(), 1, "UNK"); return err }},
+		{"ParseOFXAmount", func() error { _, err := ParseOFXAmount("1.00", "UNK"); return err }},
+		{"ParseQIFAmount", func() error { _, err := ParseQIFAmount("T1.00", "UNK", "."); return err }},
+		{"NewFromAdyen", func() error { _, err := NewFromAdyen(AdyenAmount{Currency: "UNK", Value: 100}); return err }},
+		{"NewFromQuickBooks", func() error {
+			_, err := NewFromQuickBooks(QuickBooksAmount{Amount: "1.00", CurrencyRef: CurrencyRef{Value: "UNK"}})
+			return err
+		}},
+		{"NewFromOpenBanking", func() error {
+			_, err := NewFromOpenBanking(OpenBankingAmount{Amount: "1.00", Currency: "UNK"})
+			return err
+		}},
+		{"ISO20022Amount", func() error {
+			var a ISO20022Amount
+			return xml.Unmarshal([]byte(`<Amt Ccy="UNK">1.00</Amt>`), &a)
+		}},
+		{"Bag.ConvertAll", func() error { _, err := (&Bag{}).ConvertAll("UNK", rp, RoundHalfEven); return err }},
+		{"Bag.UnmarshalJSON", func() error { return json.Unmarshal([]byte(`{"UNK":100}`), &Bag{}) }},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.fn(); !errors.Is(err, ErrUnsupportedCurrency) {
+				t.Errorf("Expected %v got %v", ErrUnsupportedCurrency, err)
+			}
+		})
+	}
+
+	if m := (&Bag{}).Get("UNK"); m.Amount != 0 || m.Currency != nil {
+		t.Errorf("Expected zero Money without currency got %+v", m)
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Value is a comparable representation of Money storing the currency code inline
+// instead of a *Currency pointer. Values can be compared with == and used as map keys,
+// and the zero Value, with an empty code, is safe to use. Currency details are looked
+// up by code when needed.
+type Value struct {
+	Amount Amount `json:"amount" bson:"amount"`
+	Code   string `json:"currency" bson:"currency"`
+}
+
+// NewValue creates and returns new Value.
+func NewValue(amount int64, code string) Value {
+	return Value{Amount: Amount(amount), Code: canonicalCode(code)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The currency code is trimmed and upper-cased,
+// amounts which aren't whole minor units are rejected with ErrInvalidJSONUnmarshal.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	type value Value
+	if err := json.Unmarshal(data, (*value)(v)); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSONUnmarshal, err)
+	}
+	v.Code = canonicalCode(v.Code)
+
+	return nil
+}
+
+// ToValue converts Money into Value.
+func (m *Money) ToValue() Value {
+	return Value{Amount: m.Amount, Code: canonicalCode(m.Currency.Code)}
+}
+
+// ToMoney converts Value into new instance of Money.
+func (v Value) ToMoney() *Money {
+	return New(int64(v.Amount), v.Code)
+}
+
+// Currency returns the Currency of the Value.
+func (v Value) Currency() *Currency {
+	return newCurrency(v.Code).get().clone()
+}
+
+// Add returns new Value representing sum of Self and Other Value.
+// It returns ErrCurrencyMismatch for Values of different currencies and
+// ErrOverflow when the sum doesn't fit into Amount.
+func (v Value) Add(ov Value) (Value, error) {
+	if canonicalCode(v.Code) != canonicalCode(ov.Code) {
+		return Value{}, &CurrencyMismatchError{A: v.Code, B: ov.Code}
+	}
+
+	a, ok := mutate.calc.addChecked(v.Amount, ov.Amount)
+	if !ok {
+		return Value{}, ErrOverflow
+	}
+
+	return Value{Amount: a, Code: canonicalCode(v.Code)}, nil
+}
+
+// Subtract returns new Value representing difference of Self and Other Value.
+// It returns ErrCurrencyMismatch for Values of different currencies and
+// ErrOverflow when the difference doesn't fit into Amount.
+func (v Value) Subtract(ov Value) (Value, error) {
+	if canonicalCode(v.Code) != canonicalCode(ov.Code) {
+		return Value{}, &CurrencyMismatchError{A: v.Code, B: ov.Code}
+	}
+
+	a, ok := mutate.calc.subtractChecked(v.Amount, ov.Amount)
+	if !ok {
+		return Value{}, ErrOverflow
+	}
+
+	return Value{Amount: a, Code: canonicalCode(v.Code)}, nil
+}
+
+// IsZero returns boolean of whether the amount of Value is equals to zero.
+func (v Value) IsZero() bool {
+	return v.Amount == 0
+}
+
+// Display lets represent Value as string in its Currency.
+func (v Value) Display() string {
+	return v.Currency().Formatter().Format(int64(v.Amount))
+}
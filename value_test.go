@@ -0,0 +1,69 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestValue(t *testing.T) {
+	v := New(1099, EUR).ToValue()
+	if v != NewValue(1099, "eur") {
+		t.Errorf("Expected %v got %v", NewValue(1099, EUR), v)
+	}
+
+	totals := map[Value]int{v: 1}
+	totals[NewValue(1099, EUR)]++
+	if totals[v] != 2 {
+		t.Errorf("Expected Values to be usable as map keys got %v", totals)
+	}
+
+	m := v.ToMoney()
+	if m.Amount != 1099 || m.Currency.Code != EUR || m.Display() != v.Display() {
+		t.Errorf("Expected %v got %v", v, m)
+	}
+
+	var zero Value
+	if !zero.IsZero() || zero.Currency() == nil {
+		t.Errorf("Expected zero Value to be usable got %v", zero)
+	}
+}
+
+func TestValue_Add(t *testing.T) {
+	r, err := NewValue(100, EUR).Add(NewValue(50, EUR))
+	if err != nil || r != NewValue(150, EUR) {
+		t.Errorf("Expected %v got %v (%v)", NewValue(150, EUR), r, err)
+	}
+
+	r, err = NewValue(100, EUR).Subtract(NewValue(150, EUR))
+	if err != nil || r != NewValue(-50, EUR) {
+		t.Errorf("Expected %v got %v (%v)", NewValue(-50, EUR), r, err)
+	}
+
+	if _, err := NewValue(1, EUR).Add(NewValue(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := NewValue(math.MinInt64, EUR).Subtract(NewValue(1, EUR)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestValue_JSON(t *testing.T) {
+	b, err := json.Marshal(NewValue(-250, USD))
+	if expected := `{"amount":-250,"currency":"USD"}`; err != nil || string(b) != expected {
+		t.Errorf("Expected %s got %s (%v)", expected, b, err)
+	}
+}
+
+func TestValue_UnmarshalJSON(t *testing.T) {
+	var v Value
+	if err := json.Unmarshal([]byte(`{"amount":-250,"currency":" usd "}`), &v); err != nil || v != NewValue(-250, USD) {
+		t.Errorf("Expected %v got %v (%v)", NewValue(-250, USD), v, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"amount":2.5,"currency":"USD"}`), &v); !errors.Is(err, ErrInvalidJSONUnmarshal) {
+		t.Errorf("Expected %v got %v", ErrInvalidJSONUnmarshal, err)
+	}
+}
@@ -0,0 +1,65 @@
+package money
+
+import "fmt"
+
+// Remainder describes a leftover minor unit absorbed by a part of an allocation.
+type Remainder struct {
+	Index  int
+	Amount *Money
+}
+
+// VerifyAllocation checks that parts are a valid allocation of original by given ratios,
+// as made by Allocate, or an even split, as made by Split, when ratios is nil.
+// Every part must have the Currency of original and lie within bounds unless bounds is nil,
+// and the parts must sum exactly to original. It returns the parts which received more
+// or less than their exact share, along with the difference, in index order.
+// Failed checks are reported as errors matching ErrInvalidAllocation.
+func VerifyAllocation(original *Money, parts []*Money, ratios []int, bounds *Range) ([]Remainder, error) {
+	if ratios == nil {
+		ratios = make([]int, len(parts))
+		for i := range ratios {
+			ratios[i] = 1
+		}
+	}
+
+	if len(ratios) != len(parts) {
+		return nil, fmt.Errorf("%w: %d ratios for %d parts", ErrInvalidRatio, len(ratios), len(parts))
+	}
+
+	sum, err := ratioSum(ratios)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rs    []Remainder
+		total = &Money{Currency: original.Currency}
+	)
+	for i, p := range parts {
+		if err := original.assertSameCurrency(p); err != nil {
+			return nil, fmt.Errorf("%w: part %d: %w", ErrInvalidAllocation, i, err)
+		}
+
+		if bounds != nil {
+			if ok, err := bounds.Contains(p); err != nil || !ok {
+				return nil, fmt.Errorf("%w: part %d of %s out of bounds", ErrInvalidAllocation, i, p.Display())
+			}
+		}
+
+		a, ok := mutate.calc.addChecked(total.Amount, p.Amount)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		total.Amount = a
+
+		if d := p.Amount - mutate.calc.allocate(original.Amount, uint(ratios[i]), sum); d != 0 {
+			rs = append(rs, Remainder{Index: i, Amount: &Money{Amount: d, Currency: original.Currency}})
+		}
+	}
+
+	if total.Amount != original.Amount {
+		return nil, fmt.Errorf("%w: parts sum to %s instead of %s", ErrInvalidAllocation, total.Display(), original.Display())
+	}
+
+	return rs, nil
+}
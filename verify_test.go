@@ -0,0 +1,51 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVerifyAllocation(t *testing.T) {
+	m := New(100, EUR)
+
+	parts, _ := m.Allocate(30, 30, 30)
+	rs, err := VerifyAllocation(m, parts, []int{30, 30, 30}, nil)
+	if err != nil || len(rs) != 1 || rs[0].Index != 0 || rs[0].Amount.Amount != 1 {
+		t.Errorf("Expected remainder of %d at index %d got %+v (%v)", 1, 0, rs, err)
+	}
+
+	parts, _ = m.Split(3)
+	if rs, err := VerifyAllocation(m, parts, nil, nil); err != nil || len(rs) != 1 {
+		t.Errorf("Expected one remainder got %+v (%v)", rs, err)
+	}
+
+	parts, _ = New(-5, EUR).Split(3)
+	rs, err = VerifyAllocation(New(-5, EUR), parts, nil, nil)
+	if err != nil || len(rs) != 2 || rs[1].Index != 1 || rs[1].Amount.Amount != -1 {
+		t.Errorf("Expected remainders of %d at indexes 0 and 1 got %+v (%v)", -1, rs, err)
+	}
+}
+
+func TestVerifyAllocation_Errors(t *testing.T) {
+	m := New(100, EUR)
+	max := &Range{Max: New(40, EUR)}
+
+	tcs := []struct {
+		parts  []*Money
+		ratios []int
+		bounds *Range
+		err    error
+	}{
+		{moneys(EUR, 50, 49), nil, nil, ErrInvalidAllocation},
+		{moneys(EUR, 50, 50), nil, max, ErrInvalidAllocation},
+		{[]*Money{New(50, EUR), New(50, USD)}, nil, nil, ErrCurrencyMismatch},
+		{moneys(EUR, 50, 50), []int{1}, nil, ErrInvalidRatio},
+		{moneys(EUR, 50, 50), []int{1, -1}, nil, ErrInvalidRatio},
+	}
+
+	for _, tc := range tcs {
+		if _, err := VerifyAllocation(m, tc.parts, tc.ratios, tc.bounds); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %v got %v", tc.err, amounts(tc.parts), err)
+		}
+	}
+}